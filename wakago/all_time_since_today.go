@@ -34,11 +34,17 @@ type AllTimeSinceTodayRange struct {
 }
 
 func (service *AllTimeSinceTodayService) Get(ctx context.Context, userId string, project *string) (*AllTimeSinceToday, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, project)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *AllTimeSinceTodayService) GetWithResponse(ctx context.Context, userId string, project *string) (*AllTimeSinceToday, *Response, error) {
 	path := fmt.Sprintf("users/%v/all_time_since_today", userId)
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if project != nil {
@@ -48,10 +54,10 @@ func (service *AllTimeSinceTodayService) Get(ctx context.Context, userId string,
 	}
 
 	v := new(AllTimeSinceToday)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
 }
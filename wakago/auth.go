@@ -0,0 +1,94 @@
+package wakago
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// The query parameter WakaTime accepts the secret API key in.
+const apiKeyQueryParam = "api_key"
+
+// Authenticator adds credentials to every request the client sends to its base URL, including retries.
+type Authenticator interface {
+	Authenticate(request *http.Request) error
+}
+
+// AuthenticatorFunc adapts a function to an Authenticator.
+type AuthenticatorFunc func(request *http.Request) error
+
+func (f AuthenticatorFunc) Authenticate(request *http.Request) error {
+	return f(request)
+}
+
+// APIKeyAuth authenticates with the secret API key using HTTP Basic auth.
+type APIKeyAuth struct {
+	APIKey string
+}
+
+func (a APIKeyAuth) Authenticate(request *http.Request) error {
+	if a.APIKey == "" {
+		return errors.New("api key is empty")
+	}
+
+	request.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(a.APIKey)))
+	return nil
+}
+
+// APIKeyQueryAuth authenticates with the secret API key in the api_key query parameter.
+// The key is redacted from URLs in errors and logs.
+type APIKeyQueryAuth struct {
+	APIKey string
+}
+
+func (a APIKeyQueryAuth) Authenticate(request *http.Request) error {
+	if a.APIKey == "" {
+		return errors.New("api key is empty")
+	}
+
+	q := request.URL.Query()
+	q.Set(apiKeyQueryParam, a.APIKey)
+	request.URL.RawQuery = q.Encode()
+	return nil
+}
+
+// BearerTokenAuth authenticates with an OAuth access token.
+type BearerTokenAuth struct {
+	Token string
+}
+
+func (a BearerTokenAuth) Authenticate(request *http.Request) error {
+	if a.Token == "" {
+		return errors.New("access token is empty")
+	}
+
+	request.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// isAPIURL reports whether u is under the client's base URL, so credentials are never sent to other hosts
+// such as the pre-signed data dump download URLs.
+func (c *Client) isAPIURL(u *url.URL) bool {
+	return strings.EqualFold(u.Scheme, c.baseURL.Scheme) &&
+		strings.EqualFold(u.Host, c.baseURL.Host) &&
+		strings.HasPrefix(u.Path, c.baseURL.Path)
+}
+
+// redactURL returns u as a string with the api_key query parameter masked.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	q := u.Query()
+	if !q.Has(apiKeyQueryParam) {
+		return u.String()
+	}
+
+	q.Set(apiKeyQueryParam, "REDACTED")
+	redacted := *u
+	redacted.RawQuery = q.Encode()
+	return redacted.String()
+}
@@ -0,0 +1,118 @@
+package wakago
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyAuth(t *testing.T) {
+	request, _ := http.NewRequest("GET", "https://wakatime.com/api/v1/users/current", nil)
+
+	err := APIKeyAuth{APIKey: "secret"}.Authenticate(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "Basic c2VjcmV0", request.Header.Get("Authorization"))
+
+	err = APIKeyAuth{}.Authenticate(request)
+	assert.Error(t, err)
+}
+
+func TestAPIKeyQueryAuth(t *testing.T) {
+	request, _ := http.NewRequest("GET", "https://wakatime.com/api/v1/users/current?timeout=15", nil)
+
+	err := APIKeyQueryAuth{APIKey: "secret"}.Authenticate(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "api_key=secret&timeout=15", request.URL.RawQuery)
+	assert.Empty(t, request.Header.Get("Authorization"))
+}
+
+func TestBearerTokenAuth(t *testing.T) {
+	request, _ := http.NewRequest("GET", "https://wakatime.com/api/v1/users/current", nil)
+
+	err := BearerTokenAuth{Token: "waka_tok_xxx"}.Authenticate(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer waka_tok_xxx", request.Header.Get("Authorization"))
+}
+
+func TestWithAuthenticator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var authorization string
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", func(request *http.Request) (*http.Response, error) {
+		authorization = request.Header.Get("Authorization")
+		return httpmock.NewStringResponse(200, `{}`), nil
+	})
+
+	c, _ := New(WithAccessToken("waka_tok_xxx"))
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(context.Background(), request, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer waka_tok_xxx", authorization)
+	assert.Empty(t, request.Header.Get("Authorization"))
+}
+
+func TestWithAuthenticator_error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	c, _ := New(WithAuthenticator(AuthenticatorFunc(func(request *http.Request) error {
+		return errors.New("no credentials")
+	})))
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(context.Background(), request, nil)
+	assert.EqualError(t, err, "no credentials")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestWithAuthenticator_redactsAPIKey(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterNoResponder(httpmock.NewErrorResponder(errors.New("connection refused")))
+
+	logger := &testLogger{}
+	c, _ := New(WithAuthenticator(APIKeyQueryAuth{APIKey: "secret"}), WithLogger(logger))
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(context.Background(), request, nil)
+	if assert.Error(t, err) {
+		assert.NotContains(t, err.Error(), "secret")
+		assert.Contains(t, err.Error(), "api_key=REDACTED")
+	}
+
+	if assert.Len(t, logger.lines, 1) {
+		assert.NotContains(t, logger.lines[0], "secret")
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://wakatime.com/api/v1/foo?api_key=secret&page=2")
+	assert.Equal(t, "https://wakatime.com/api/v1/foo?api_key=REDACTED&page=2", redactURL(u))
+	assert.Equal(t, "https://wakatime.com/api/v1/foo?api_key=secret&page=2", u.String())
+
+	u, _ = url.Parse("https://wakatime.com/api/v1/foo?page=2")
+	assert.Equal(t, "https://wakatime.com/api/v1/foo?page=2", redactURL(u))
+}
+
+func TestClient_isAPIURL(t *testing.T) {
+	c, _ := New(WithBaseURL("https://wakapi.example.com/api/compat/wakatime/v1"))
+
+	for rawURL, expected := range map[string]bool{
+		"https://wakapi.example.com/api/compat/wakatime/v1/users/current": true,
+		"https://WAKAPI.example.com/api/compat/wakatime/v1/users/current": true,
+		"http://wakapi.example.com/api/compat/wakatime/v1/users/current":  false,
+		"https://wakapi.example.com/other/users/current":                  false,
+		"https://wakatime.s3.amazonaws.com/dumps/dump.json":               false,
+	} {
+		u, _ := url.Parse(rawURL)
+		assert.Equal(t, expected, c.isAPIURL(u), rawURL)
+	}
+}
@@ -0,0 +1,80 @@
+package wakago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// bulkResponses is the body returned from the ".bulk" endpoints.
+// Each response is encoded as a two element array of the body and its status code.
+type bulkResponses struct {
+	Responses []bulkResponse `json:"responses"`
+}
+
+type bulkResponse struct {
+	Body       json.RawMessage
+	StatusCode int
+}
+
+func (r *bulkResponse) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+
+	if len(pair) != 2 {
+		return fmt.Errorf("unexpected bulk response : %s", data)
+	}
+
+	r.Body = pair[0]
+	return json.Unmarshal(pair[1], &r.StatusCode)
+}
+
+type deleteBulkBody struct {
+	Date string   `json:"date"`
+	Ids  []string `json:"ids"`
+}
+
+// postBulk sends items to a ".bulk" endpoint in chunks of at most limit items.
+// The responses of every chunk sent so far are returned, in input order, even when a later chunk fails.
+func postBulk[T any](ctx context.Context, client *Client, path string, items []T, limit int) ([]bulkResponse, error) {
+	responses := make([]bulkResponse, 0, len(items))
+	for start := 0; start < len(items); start += limit {
+		end := start + limit
+		if end > len(items) {
+			end = len(items)
+		}
+
+		request, err := client.NewRequest("POST", path, items[start:end])
+		if err != nil {
+			return responses, err
+		}
+
+		v := new(bulkResponses)
+		_, err = client.Do(ctx, request, v)
+		if err != nil {
+			return responses, err
+		}
+
+		if len(v.Responses) != end-start {
+			return responses, fmt.Errorf("expected %v bulk responses but got %v", end-start, len(v.Responses))
+		}
+
+		responses = append(responses, v.Responses...)
+	}
+
+	return responses, nil
+}
+
+func bulkResultErr(statusCode int, message string) error {
+	if err := CheckHttpStatusCode(statusCode); err != nil {
+		if message != "" {
+			return fmt.Errorf("%w: %v", err, message)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -110,44 +110,80 @@ type CommitsGetOptions struct {
 }
 
 func (service *CommitsService) GetAll(ctx context.Context, userId string, project string, opts *CommitsGetOptions) (*Commits, error) {
+	v, _, err := service.GetAllWithResponse(ctx, userId, project, opts)
+	return v, err
+}
+
+// GetAllWithResponse is like GetAll, and also returns the API response.
+func (service *CommitsService) GetAllWithResponse(ctx context.Context, userId string, project string, opts *CommitsGetOptions) (*Commits, *Response, error) {
 	path := fmt.Sprintf("users/%v/projects/%v/commits", userId, project)
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if opts != nil {
 		qv, err := query.Values(opts)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		request.URL.RawQuery = qv.Encode()
 	}
 
 	v := new(Commits)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
 }
 
 func (service *CommitsService) Get(ctx context.Context, userId string, project string, hash string, branch *string) (*Commit, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, project, hash, branch)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *CommitsService) GetWithResponse(ctx context.Context, userId string, project string, hash string, branch *string) (*Commit, *Response, error) {
 	path := fmt.Sprintf("users/%v/projects/%v/commits/%v", userId, project, hash)
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	v := new(Commit)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
+}
+
+// Iterate returns an iterator over every page of commits, starting at opts.Page when it is set.
+func (service *CommitsService) Iterate(userId string, project string, opts *CommitsGetOptions) *PageIterator[Commits] {
+	o := CommitsGetOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	start := 1
+	if o.Page != nil {
+		start = *o.Page
+	}
+
+	return newPageIterator(start, func(ctx context.Context, page int) (*Commits, *Response, int, error) {
+		o.Page = &page
+
+		v, response, err := service.GetAllWithResponse(ctx, userId, project, &o)
+		if err != nil {
+			return nil, response, 0, err
+		}
+
+		return v, response, int(v.NextPage.ValueOrZero()), nil
+	})
 }
@@ -371,3 +371,27 @@ func TestCommits_Get(t *testing.T) {
 	assert.Equal(t, 1, httpmock.GetTotalCallCount())
 	assert.EqualValues(t, &expected, res)
 }
+
+func TestCommits_Iterate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/projects/wakago/commits"
+	httpmock.RegisterResponderWithQuery("GET", url, "branch=main&page=1",
+		httpmock.NewStringResponder(200, `{"commits": [{"hash": "633785770b4ac4e5a0acc80395bd6a015486c6a0"}], "page": 1, "next_page": 2}`))
+	httpmock.RegisterResponderWithQuery("GET", url, "branch=main&page=2",
+		httpmock.NewStringResponder(200, `{"commits": [{"hash": "8f2c1b0e5d9a4c7b3e6f1a2d4c8b9e0f7a6d5c3b"}], "page": 2, "next_page": null}`))
+
+	branch := "main"
+	client := NewClient(nil)
+	it := client.CommitsService.Iterate("current", "wakago", &CommitsGetOptions{Branch: &branch})
+
+	hashes := []string{}
+	for it.Next(context.Background()) {
+		hashes = append(hashes, it.Page().Commits[0].Hash)
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"633785770b4ac4e5a0acc80395bd6a015486c6a0", "8f2c1b0e5d9a4c7b3e6f1a2d4c8b9e0f7a6d5c3b"}, hashes)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,100 @@
+package wakago
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The path Wakapi serves its WakaTime compatible API under.
+const wakapiCompatPath = "api/compat/wakatime/v1/"
+
+// WakapiBaseURL returns the WakaTime compatible API base URL of the Wakapi server at serverURL.
+func WakapiBaseURL(serverURL string) string {
+	return strings.TrimSuffix(serverURL, "/") + "/" + wakapiCompatPath
+}
+
+func parseBaseURL(rawURL string) (*url.URL, error) {
+	if !strings.HasSuffix(rawURL, "/") {
+		rawURL += "/"
+	}
+
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if !baseURL.IsAbs() || baseURL.Host == "" {
+		return nil, fmt.Errorf("base URL must be absolute : %v", rawURL)
+	}
+
+	return baseURL, nil
+}
+
+// Timestamp layouts WakaTime compatible servers are known to send instead of RFC 3339.
+var compatTimeLayouts = []string{
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// normalizeCompatJSON rewrites a response body from a WakaTime compatible server so it decodes into the WakaTime types.
+// Empty timestamps are dropped and timestamps in other layouts are converted to RFC 3339.
+func normalizeCompatJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(normalizeCompatValue(v))
+}
+
+func normalizeCompatValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if s, ok := field.(string); ok && isCompatTimeKey(key) {
+				if s == "" {
+					delete(value, key)
+					continue
+				}
+
+				value[key] = normalizeCompatTime(s)
+				continue
+			}
+
+			value[key] = normalizeCompatValue(field)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = normalizeCompatValue(item)
+		}
+	}
+
+	return v
+}
+
+func isCompatTimeKey(key string) bool {
+	return strings.HasSuffix(key, "_at") || key == "start" || key == "end" || key == "expires"
+}
+
+func normalizeCompatTime(s string) string {
+	if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return s
+	}
+
+	for _, layout := range compatTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format(time.RFC3339Nano)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,53 @@
+package wakago
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWakapiBaseURL(t *testing.T) {
+	assert.Equal(t, "https://wakapi.dev/api/compat/wakatime/v1/", WakapiBaseURL("https://wakapi.dev"))
+	assert.Equal(t, "https://example.com/wakapi/api/compat/wakatime/v1/", WakapiBaseURL("https://example.com/wakapi/"))
+}
+
+func TestParseBaseURL(t *testing.T) {
+	u, err := parseBaseURL("https://wakapi.dev/api/compat/wakatime/v1")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://wakapi.dev/api/compat/wakatime/v1/", u.String())
+
+	_, err = parseBaseURL("wakapi.dev/api")
+	assert.NotNil(t, err)
+
+	_, err = parseBaseURL("://wakapi.dev")
+	assert.NotNil(t, err)
+}
+
+func TestNormalizeCompatJSON(t *testing.T) {
+	data := `
+	{
+		"cached_at": "2022-10-27 10:30:16",
+		"data": [
+			{"created_at": "", "start": "2022-10-26T15:00:00+0900", "time": 1666866121.027658},
+			{"created_at": "2022-10-27T10:30:16.123Z", "end": "2022-10-27T14:59:59", "name": ""}
+		],
+		"range": {"start": 1666710000}
+	}`
+
+	expected := `
+	{
+		"cached_at": "2022-10-27T10:30:16Z",
+		"data": [
+			{"start": "2022-10-26T15:00:00+09:00", "time": 1666866121.027658},
+			{"created_at": "2022-10-27T10:30:16.123Z", "end": "2022-10-27T14:59:59Z", "name": ""}
+		],
+		"range": {"start": 1666710000}
+	}`
+
+	normalized, err := normalizeCompatJSON([]byte(data))
+	assert.Nil(t, err)
+	assert.JSONEq(t, expected, string(normalized))
+
+	_, err = normalizeCompatJSON([]byte(`{`))
+	assert.NotNil(t, err)
+}
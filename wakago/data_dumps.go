@@ -0,0 +1,313 @@
+package wakago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type DataDumpsService service
+
+type DataDumpType string
+
+const (
+	DataDumpTypeDaily      DataDumpType = "daily"
+	DataDumpTypeHeartbeats DataDumpType = "heartbeats"
+)
+
+type DataDumps struct {
+	Data       []DataDumpsData `json:"data"`
+	Total      int             `json:"total"`
+	TotalPages int             `json:"total_pages"`
+}
+
+type DataDump struct {
+	Data DataDumpsData `json:"data"`
+}
+
+type DataDumpsData struct {
+	Id              string       `json:"id"`
+	Type            DataDumpType `json:"type"`
+	Status          string       `json:"status"`
+	PercentComplete float64      `json:"percent_complete"`
+	IsProcessing    bool         `json:"is_processing"`
+	IsStuck         bool         `json:"is_stuck"`
+	HasFailed       bool         `json:"has_failed"`
+	DownloadUrl     null.String  `json:"download_url"`
+	Expires         null.Time    `json:"expires"`
+	CreatedAt       time.Time    `json:"created_at"`
+}
+
+func (d *DataDumpsData) IsCompleted() bool {
+	return strings.EqualFold(d.Status, "completed") && d.DownloadUrl.ValueOrZero() != ""
+}
+
+type DataDumpsCreateOptions struct {
+	Type              DataDumpType `json:"type"`
+	EmailWhenFinished bool         `json:"email_when_finished"`
+}
+
+// DataDumpDay is a single day of a data dump.
+// Heartbeats is set for heartbeats dumps, the remaining fields for daily dumps.
+type DataDumpDay struct {
+	Date             string               `json:"date"`
+	Heartbeats       []HeartbeatsData     `json:"heartbeats"`
+	GrandTotal       *SummariesGrandTotal `json:"grand_total"`
+	Categories       []StatsItem          `json:"categories"`
+	Dependencies     []StatsItem          `json:"dependencies"`
+	Editors          []StatsItem          `json:"editors"`
+	Languages        []StatsItem          `json:"languages"`
+	Machines         []StatsMachineItem   `json:"machines"`
+	OperatingSystems []StatsItem          `json:"operating_systems"`
+	Projects         []DataDumpProject    `json:"projects"`
+}
+
+type DataDumpProject struct {
+	Name       string              `json:"name"`
+	GrandTotal SummariesGrandTotal `json:"grand_total"`
+}
+
+// DataDumpDecoder reads the days of a data dump one at a time, so a dump never has to fit in memory.
+type DataDumpDecoder struct {
+	dec *json.Decoder
+
+	// User is the raw "user" object of the dump, available once the decoder has read past it.
+	User json.RawMessage
+
+	started bool
+	inDays  bool
+	done    bool
+}
+
+func NewDataDumpDecoder(r io.Reader) *DataDumpDecoder {
+	return &DataDumpDecoder{dec: json.NewDecoder(r)}
+}
+
+// Next returns the next day of the dump, or io.EOF once every day has been read.
+func (d *DataDumpDecoder) Next() (*DataDumpDay, error) {
+	if d.done {
+		return nil, io.EOF
+	}
+
+	if !d.started {
+		if err := d.expectDelim('{'); err != nil {
+			return nil, err
+		}
+		d.started = true
+	}
+
+	for {
+		if d.inDays {
+			if d.dec.More() {
+				day := new(DataDumpDay)
+				if err := d.dec.Decode(day); err != nil {
+					return nil, err
+				}
+
+				return day, nil
+			}
+
+			if err := d.expectDelim(']'); err != nil {
+				return nil, err
+			}
+			d.inDays = false
+		}
+
+		if !d.dec.More() {
+			if err := d.expectDelim('}'); err != nil {
+				return nil, err
+			}
+			d.done = true
+			return nil, io.EOF
+		}
+
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token {
+		case "days":
+			if err := d.expectDelim('['); err != nil {
+				return nil, err
+			}
+			d.inDays = true
+		case "user":
+			if err := d.dec.Decode(&d.User); err != nil {
+				return nil, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := d.dec.Decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (d *DataDumpDecoder) expectDelim(delim json.Delim) error {
+	token, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("unexpected token in data dump : %v", token)
+	}
+
+	return nil
+}
+
+func (service *DataDumpsService) GetAll(ctx context.Context, userId string) (*DataDumps, error) {
+	v, _, err := service.GetAllWithResponse(ctx, userId)
+	return v, err
+}
+
+// GetAllWithResponse is like GetAll, and also returns the API response.
+func (service *DataDumpsService) GetAllWithResponse(ctx context.Context, userId string) (*DataDumps, *Response, error) {
+	path := fmt.Sprintf("users/%v/data_dumps", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(DataDumps)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *DataDumpsService) Create(ctx context.Context, userId string, opts *DataDumpsCreateOptions) (*DataDump, error) {
+	v, _, err := service.CreateWithResponse(ctx, userId, opts)
+	return v, err
+}
+
+// CreateWithResponse is like Create, and also returns the API response.
+func (service *DataDumpsService) CreateWithResponse(ctx context.Context, userId string, opts *DataDumpsCreateOptions) (*DataDump, *Response, error) {
+	path := fmt.Sprintf("users/%v/data_dumps", userId)
+
+	request, err := service.client.NewRequest("POST", path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(DataDump)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// Wait lists the data dumps every interval until dumpId has completed, failed or ctx ends.
+func (service *DataDumpsService) Wait(ctx context.Context, userId string, dumpId string, interval time.Duration) (*DataDumpsData, error) {
+	v, _, err := service.WaitWithResponse(ctx, userId, dumpId, interval)
+	return v, err
+}
+
+// WaitWithResponse is like Wait, and also returns the API response to the last request.
+func (service *DataDumpsService) WaitWithResponse(ctx context.Context, userId string, dumpId string, interval time.Duration) (*DataDumpsData, *Response, error) {
+	if interval <= 0 {
+		return nil, nil, fmt.Errorf("interval must be positive : %v", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		dumps, response, err := service.GetAllWithResponse(ctx, userId)
+		if err != nil {
+			return nil, response, err
+		}
+
+		var dump *DataDumpsData
+		for i := range dumps.Data {
+			if dumps.Data[i].Id == dumpId {
+				dump = &dumps.Data[i]
+			}
+		}
+
+		if dump == nil {
+			return nil, response, fmt.Errorf("data dump %w : %v", ErrNotFound, dumpId)
+		}
+
+		if dump.HasFailed {
+			return dump, response, fmt.Errorf("data dump failed : %v", dumpId)
+		}
+
+		if dump.IsCompleted() {
+			return dump, response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return dump, response, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Download streams the dump file to w without buffering it.
+func (service *DataDumpsService) Download(ctx context.Context, dump *DataDumpsData, w io.Writer) error {
+	_, err := service.DownloadWithResponse(ctx, dump, w)
+	return err
+}
+
+// DownloadWithResponse is like Download, and also returns the API response.
+func (service *DataDumpsService) DownloadWithResponse(ctx context.Context, dump *DataDumpsData, w io.Writer) (*Response, error) {
+	if !dump.DownloadUrl.Valid || dump.DownloadUrl.String == "" {
+		return nil, errors.New("data dump has no download url")
+	}
+
+	// The download url is pre-signed, so the request is built without the client's default headers,
+	// and Client.Do does not authenticate it as it is not under the base URL.
+	request, err := http.NewRequest("GET", dump.DownloadUrl.String, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if service.client.UserAgent != "" {
+		request.Header.Set("User-Agent", service.client.UserAgent)
+	}
+
+	return service.client.DoWithResponse(ctx, request, w)
+}
+
+// DownloadDays streams the dump file and calls fn for each day as soon as it has been decoded.
+// Returning an error from fn stops the download.
+func (service *DataDumpsService) DownloadDays(ctx context.Context, dump *DataDumpsData, fn func(day *DataDumpDay) error) error {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	go func() {
+		pw.CloseWithError(service.Download(ctx, dump, pw))
+	}()
+
+	dec := NewDataDumpDecoder(pr)
+	for {
+		day, err := dec.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := fn(day); err != nil {
+			return err
+		}
+	}
+}
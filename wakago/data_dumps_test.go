@@ -0,0 +1,279 @@
+package wakago
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+const dummyDataDumpFile = `
+{
+	"range": {"start": 1666710000, "end": 1666882799},
+	"days": [
+		{
+			"date": "2022-10-26",
+			"heartbeats": [
+				{"entity": "wakago.go", "type": "file", "time": 1666866121.5, "project": "wakago"}
+			]
+		},
+		{
+			"date": "2022-10-27",
+			"heartbeats": []
+		}
+	],
+	"user": {"id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", "username": "yamash723"}
+}`
+
+func TestDataDumps_GetAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"created_at": "2022-10-27T10:22:05Z",
+				"download_url": "https://wakatime.s3.amazonaws.com/dumps/dump.json?signature=xxx",
+				"expires": "2022-11-03T10:22:05Z",
+				"has_failed": false,
+				"id": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+				"is_processing": false,
+				"is_stuck": false,
+				"percent_complete": 100,
+				"status": "Completed",
+				"type": "heartbeats"
+			}
+		],
+		"total": 1,
+		"total_pages": 1
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/data_dumps"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.DataDumpsService.GetAll(context.Background(), "current")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := DataDumps{
+		Data: []DataDumpsData{
+			{
+				Id:              "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+				Type:            DataDumpTypeHeartbeats,
+				Status:          "Completed",
+				PercentComplete: 100,
+				DownloadUrl:     null.StringFrom("https://wakatime.s3.amazonaws.com/dumps/dump.json?signature=xxx"),
+				Expires:         null.TimeFrom(time.Date(2022, 11, 3, 10, 22, 5, 0, time.UTC)),
+				CreatedAt:       time.Date(2022, 10, 27, 10, 22, 5, 0, time.UTC),
+			},
+		},
+		Total:      1,
+		TotalPages: 1,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+	assert.True(t, res.Data[0].IsCompleted())
+}
+
+func TestDataDumps_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/data_dumps"
+	httpmock.RegisterResponder("POST", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, `{"type":"daily","email_when_finished":false}`, string(body))
+		return httpmock.NewStringResponse(201, `{"data": {"id": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", "type": "daily", "status": "Pending…", "is_processing": true}}`), nil
+	})
+
+	client := NewClient(nil)
+	res, err := client.DataDumpsService.Create(context.Background(), "current", &DataDumpsCreateOptions{Type: DataDumpTypeDaily})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := DataDump{
+		Data: DataDumpsData{
+			Id:           "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+			Type:         DataDumpTypeDaily,
+			Status:       "Pending…",
+			IsProcessing: true,
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+	assert.False(t, res.Data.IsCompleted())
+}
+
+func TestDataDumps_Wait(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processing := `{"data": [{"id": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", "status": "Processing coding activity…", "is_processing": true, "percent_complete": 40}]}`
+	completed := `{"data": [{"id": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", "status": "Completed", "percent_complete": 100, "download_url": "https://wakatime.s3.amazonaws.com/dumps/dump.json"}]}`
+
+	calls := 0
+	url := "https://wakatime.com/api/v1/users/current/data_dumps"
+	httpmock.RegisterResponder("GET", url, func(request *http.Request) (*http.Response, error) {
+		calls++
+		if calls < 2 {
+			return httpmock.NewStringResponse(200, processing), nil
+		}
+
+		return httpmock.NewStringResponse(200, completed), nil
+	})
+
+	client := NewClient(nil)
+	res, err := client.DataDumpsService.Wait(context.Background(), "current", "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", time.Millisecond)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	assert.True(t, res.IsCompleted())
+
+	_, err = client.DataDumpsService.Wait(context.Background(), "current", "unknown", time.Millisecond)
+	assert.NotNil(t, err)
+}
+
+func TestDataDumps_Wait_invalidInterval(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := NewClient(nil)
+	_, err := client.DataDumpsService.Wait(context.Background(), "current", "dump-id", -time.Second)
+
+	assert.EqualError(t, err, "interval must be positive : -1s")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestDataDumps_Download(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.s3.amazonaws.com/dumps/dump.json"
+	httpmock.RegisterResponder("GET", url, func(request *http.Request) (*http.Response, error) {
+		assert.Empty(t, request.Header.Get("Authorization"))
+		return httpmock.NewStringResponse(200, dummyDataDumpFile), nil
+	})
+
+	client := NewClient(nil)
+	client.DefaultHeader = &http.Header{"Authorization": []string{"Basic xxx"}}
+	dump := &DataDumpsData{Status: "Completed", DownloadUrl: null.StringFrom(url)}
+
+	buf := &bytes.Buffer{}
+	err := client.DataDumpsService.Download(context.Background(), dump, buf)
+
+	assert.Nil(t, err)
+	assert.Equal(t, dummyDataDumpFile, buf.String())
+
+	err = client.DataDumpsService.Download(context.Background(), &DataDumpsData{}, buf)
+	assert.NotNil(t, err)
+}
+
+func TestDataDumps_Download_authenticator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.s3.amazonaws.com/dumps/dump.json"
+	httpmock.RegisterResponder("GET", url, func(request *http.Request) (*http.Response, error) {
+		assert.Empty(t, request.Header.Get("Authorization"))
+		assert.False(t, request.URL.Query().Has("api_key"))
+		return httpmock.NewStringResponse(200, dummyDataDumpFile), nil
+	})
+
+	dump := &DataDumpsData{Status: "Completed", DownloadUrl: null.StringFrom(url)}
+	for _, opt := range []Option{WithAPIKey("secret"), WithAccessToken("waka_tok_xxx"), WithAuthenticator(APIKeyQueryAuth{APIKey: "secret"})} {
+		client, _ := New(opt)
+
+		buf := &bytes.Buffer{}
+		err := client.DataDumpsService.Download(context.Background(), dump, buf)
+
+		assert.Nil(t, err)
+		assert.Equal(t, dummyDataDumpFile, buf.String())
+	}
+
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestDataDumps_DownloadDays(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.s3.amazonaws.com/dumps/dump.json"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyDataDumpFile))
+
+	client := NewClient(nil)
+	dump := &DataDumpsData{Status: "Completed", DownloadUrl: null.StringFrom(url)}
+
+	days := []DataDumpDay{}
+	err := client.DataDumpsService.DownloadDays(context.Background(), dump, func(day *DataDumpDay) error {
+		days = append(days, *day)
+		return nil
+	})
+
+	assert.Nil(t, err)
+
+	expected := []DataDumpDay{
+		{
+			Date: "2022-10-26",
+			Heartbeats: []HeartbeatsData{
+				{Entity: "wakago.go", Type: "file", Time: 1666866121.5, Project: "wakago"},
+			},
+		},
+		{
+			Date:       "2022-10-27",
+			Heartbeats: []HeartbeatsData{},
+		},
+	}
+	assert.Equal(t, expected, days)
+
+	stop := errors.New("stop")
+	err = client.DataDumpsService.DownloadDays(context.Background(), dump, func(day *DataDumpDay) error {
+		return stop
+	})
+	assert.Equal(t, stop, err)
+}
+
+func TestDataDumpDecoder(t *testing.T) {
+	dec := NewDataDumpDecoder(strings.NewReader(dummyDataDumpFile))
+
+	day, err := dec.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-10-26", day.Date)
+
+	day, err = dec.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-10-27", day.Date)
+
+	_, err = dec.Next()
+	assert.Equal(t, io.EOF, err)
+	assert.JSONEq(t, `{"id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", "username": "yamash723"}`, string(dec.User))
+
+	_, err = dec.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDataDumpDecoder_invalid(t *testing.T) {
+	dec := NewDataDumpDecoder(strings.NewReader(`["not", "a", "dump"]`))
+
+	_, err := dec.Next()
+	assert.NotNil(t, err)
+}
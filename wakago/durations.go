@@ -35,27 +35,33 @@ type DurationsGetOptions struct {
 }
 
 func (service *DurationsService) Get(ctx context.Context, userId string, opts *DurationsGetOptions) (*Durations, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *DurationsService) GetWithResponse(ctx context.Context, userId string, opts *DurationsGetOptions) (*Durations, *Response, error) {
 	path := fmt.Sprintf("users/%v/durations", userId)
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if opts != nil {
 		qv, err := query.Values(opts)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		request.URL.RawQuery = qv.Encode()
 	}
 
 	v := new(Durations)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
 }
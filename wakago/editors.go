@@ -29,27 +29,33 @@ type EditorsGetOptions struct {
 }
 
 func (service *EditorsService) Get(ctx context.Context, opts *EditorsGetOptions) (*Editors, error) {
+	v, _, err := service.GetWithResponse(ctx, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *EditorsService) GetWithResponse(ctx context.Context, opts *EditorsGetOptions) (*Editors, *Response, error) {
 	path := "editors"
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if opts != nil {
 		qv, err := query.Values(opts)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		request.URL.RawQuery = qv.Encode()
 	}
 
 	v := new(Editors)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
 }
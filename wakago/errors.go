@@ -0,0 +1,148 @@
+package wakago
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors matched by ErrorResponse and the errors of bulk results with errors.Is.
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// The most of an error response body read, as it is only needed for its message.
+const maxErrorBodySize = 1 << 20
+
+// ErrorResponse is returned by Client.Do when the API responds with an error status code.
+// URL has the api_key query parameter redacted, and Response.Body can still be read.
+type ErrorResponse struct {
+	Response   *http.Response
+	Method     string
+	URL        string
+	StatusCode int
+
+	// Message is the "error" field of the body, and Errors the "errors" field keyed by the invalid field.
+	// Errors sent as a plain list are keyed by "".
+	Message string
+	Errors  map[string][]string
+}
+
+func (e *ErrorResponse) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v %v : %v %v", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+
+	if e.Message != "" {
+		fmt.Fprintf(&b, " : %v", e.Message)
+	}
+
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		if field == "" {
+			fmt.Fprintf(&b, " : %v", strings.Join(e.Errors[field], ", "))
+		} else {
+			fmt.Fprintf(&b, " : %v %v", field, strings.Join(e.Errors[field], ", "))
+		}
+	}
+
+	return b.String()
+}
+
+func (e *ErrorResponse) Is(target error) bool {
+	return statusIs(e.StatusCode, target)
+}
+
+// statusError is the error of CheckHttpStatusCode.
+type statusError int
+
+func (e statusError) Error() string {
+	return fmt.Sprintf("Return error HTTP response code : %v", int(e))
+}
+
+func (e statusError) Is(target error) bool {
+	return statusIs(int(e), target)
+}
+
+func statusIs(statusCode int, target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return statusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return statusCode == http.StatusForbidden
+	case ErrNotFound:
+		return statusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return statusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return statusCode >= 500
+	}
+
+	return false
+}
+
+// newErrorResponse reads the error body of response, leaving a copy of it in response.Body.
+func newErrorResponse(request *http.Request, response *http.Response) *ErrorResponse {
+	e := &ErrorResponse{
+		Response:   response,
+		Method:     request.Method,
+		URL:        redactURL(request.URL),
+		StatusCode: response.StatusCode,
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxErrorBodySize))
+	response.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return e
+	}
+
+	var body struct {
+		Error  string          `json:"error"`
+		Errors json.RawMessage `json:"errors"`
+	}
+
+	if json.Unmarshal(data, &body) != nil {
+		return e
+	}
+
+	e.Message = body.Error
+	if len(body.Errors) == 0 {
+		return e
+	}
+
+	var fieldErrors map[string][]string
+	if json.Unmarshal(body.Errors, &fieldErrors) == nil {
+		e.Errors = fieldErrors
+		return e
+	}
+
+	var fieldError map[string]string
+	if json.Unmarshal(body.Errors, &fieldError) == nil {
+		e.Errors = make(map[string][]string, len(fieldError))
+		for field, message := range fieldError {
+			e.Errors[field] = []string{message}
+		}
+
+		return e
+	}
+
+	var listErrors []string
+	if json.Unmarshal(body.Errors, &listErrors) == nil {
+		e.Errors = map[string][]string{"": listErrors}
+	}
+
+	return e
+}
@@ -0,0 +1,98 @@
+package wakago
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo_errorResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	body := `{"error": "Invalid api key"}`
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(401, body))
+
+	c := NewClient(nil)
+	request, _ := c.NewRequest("GET", "foo", nil)
+	request.URL.RawQuery = "api_key=secret"
+
+	response, err := c.Do(context.Background(), request, nil)
+
+	var errorResponse *ErrorResponse
+	if assert.True(t, errors.As(err, &errorResponse)) {
+		assert.Same(t, response, errorResponse.Response)
+		assert.Equal(t, "GET", errorResponse.Method)
+		assert.Equal(t, "https://wakatime.com/api/v1/foo?api_key=REDACTED", errorResponse.URL)
+		assert.Equal(t, 401, errorResponse.StatusCode)
+		assert.Equal(t, "Invalid api key", errorResponse.Message)
+	}
+
+	assert.EqualError(t, err, "GET https://wakatime.com/api/v1/foo?api_key=REDACTED : 401 Unauthorized : Invalid api key")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.NotErrorIs(t, err, ErrForbidden)
+
+	data, _ := io.ReadAll(response.Body)
+	assert.Equal(t, body, string(data))
+}
+
+func TestDo_errorResponse_fieldErrors(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://wakatime.com/api/v1/foo",
+		httpmock.NewStringResponder(400, `{"errors": {"time": ["This field is required."], "entity": ["Invalid entity."]}}`))
+
+	c := NewClient(nil)
+	request, _ := c.NewRequest("POST", "foo", struct{}{})
+
+	_, err := c.Do(context.Background(), request, nil)
+
+	var errorResponse *ErrorResponse
+	if assert.True(t, errors.As(err, &errorResponse)) {
+		assert.Equal(t, map[string][]string{
+			"time":   {"This field is required."},
+			"entity": {"Invalid entity."},
+		}, errorResponse.Errors)
+	}
+
+	assert.EqualError(t, err, "POST https://wakatime.com/api/v1/foo : 400 Bad Request : entity Invalid entity. : time This field is required.")
+}
+
+func TestDo_errorResponse_notJSON(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(502, `<html>Bad Gateway</html>`))
+
+	c := NewClient(nil)
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(context.Background(), request, nil)
+
+	assert.EqualError(t, err, "GET https://wakatime.com/api/v1/foo : 502 Bad Gateway")
+	assert.ErrorIs(t, err, ErrServer)
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	cases := map[int]error{
+		401: ErrUnauthorized,
+		403: ErrForbidden,
+		404: ErrNotFound,
+		429: ErrRateLimited,
+		500: ErrServer,
+		503: ErrServer,
+	}
+
+	for statusCode, sentinel := range cases {
+		assert.ErrorIs(t, &ErrorResponse{StatusCode: statusCode}, sentinel)
+		assert.ErrorIs(t, CheckHttpStatusCode(statusCode), sentinel)
+	}
+
+	assert.NotErrorIs(t, &ErrorResponse{StatusCode: 400}, ErrServer)
+	assert.EqualError(t, CheckHttpStatusCode(404), "Return error HTTP response code : 404")
+}
@@ -0,0 +1,224 @@
+package wakago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"gopkg.in/guregu/null.v4"
+)
+
+type ExternalDurationsService service
+
+// ExternalDurationCategories are the categories WakaTime accepts for external durations.
+var ExternalDurationCategories = []string{
+	"coding",
+	"building",
+	"indexing",
+	"debugging",
+	"browsing",
+	"running tests",
+	"writing tests",
+	"manual testing",
+	"writing docs",
+	"code reviewing",
+	"communicating",
+	"researching",
+	"learning",
+	"designing",
+	"meeting",
+	"planning",
+}
+
+type ExternalDurations struct {
+	Data     []ExternalDurationsData `json:"data"`
+	Start    time.Time               `json:"start"`
+	End      time.Time               `json:"end"`
+	Timezone string                  `json:"timezone"`
+}
+
+type ExternalDurationsData struct {
+	Id         string      `json:"id"`
+	ExternalId string      `json:"external_id"`
+	Entity     string      `json:"entity"`
+	Type       string      `json:"type"`
+	Category   string      `json:"category"`
+	StartTime  float64     `json:"start_time"`
+	EndTime    float64     `json:"end_time"`
+	Project    null.String `json:"project"`
+	Branch     null.String `json:"branch"`
+	Language   null.String `json:"language"`
+	Meta       null.String `json:"meta"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type ExternalDuration struct {
+	Data ExternalDurationsData `json:"data"`
+}
+
+type ExternalDurationsGetOptions struct {
+	Date     string  `url:"date"`
+	Project  *string `url:"project,omitempty"`
+	Branches *string `url:"branches,omitempty"`
+	Timezone *string `url:"timezone,omitempty"`
+}
+
+type ExternalDurationsCreateOptions struct {
+	ExternalId string  `json:"external_id"`
+	Entity     string  `json:"entity"`
+	Type       string  `json:"type"`
+	StartTime  float64 `json:"start_time"`
+	EndTime    float64 `json:"end_time"`
+	Category   *string `json:"category,omitempty"`
+	Project    *string `json:"project,omitempty"`
+	Branch     *string `json:"branch,omitempty"`
+	Language   *string `json:"language,omitempty"`
+	Meta       *string `json:"meta,omitempty"`
+}
+
+// Validate checks the fields WakaTime would otherwise reject, so nothing is sent for an invalid duration.
+func (o *ExternalDurationsCreateOptions) Validate() error {
+	if o == nil {
+		return fmt.Errorf("external duration is nil")
+	}
+
+	if o.ExternalId == "" || o.Entity == "" || o.Type == "" {
+		return fmt.Errorf("external duration requires external_id, entity and type")
+	}
+
+	if o.StartTime >= o.EndTime {
+		return fmt.Errorf("external duration %v : start_time %v must be before end_time %v", o.ExternalId, o.StartTime, o.EndTime)
+	}
+
+	if o.Category != nil {
+		for _, category := range ExternalDurationCategories {
+			if *o.Category == category {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("external duration %v : invalid category %q", o.ExternalId, *o.Category)
+	}
+
+	return nil
+}
+
+// ExternalDurationsBulkResult is the outcome of a single duration sent with CreateBulk.
+type ExternalDurationsBulkResult struct {
+	StatusCode int
+	Data       *ExternalDurationsData
+	Error      string
+}
+
+func (r ExternalDurationsBulkResult) Err() error {
+	return bulkResultErr(r.StatusCode, r.Error)
+}
+
+// The maximum number of external durations sent in one bulk request.
+const externalDurationsBulkLimit = 25
+
+func (service *ExternalDurationsService) Get(ctx context.Context, userId string, opts *ExternalDurationsGetOptions) (*ExternalDurations, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *ExternalDurationsService) GetWithResponse(ctx context.Context, userId string, opts *ExternalDurationsGetOptions) (*ExternalDurations, *Response, error) {
+	path := fmt.Sprintf("users/%v/external_durations", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(ExternalDurations)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *ExternalDurationsService) Create(ctx context.Context, duration *ExternalDurationsCreateOptions) (*ExternalDuration, error) {
+	v, _, err := service.CreateWithResponse(ctx, duration)
+	return v, err
+}
+
+// CreateWithResponse is like Create, and also returns the API response.
+func (service *ExternalDurationsService) CreateWithResponse(ctx context.Context, duration *ExternalDurationsCreateOptions) (*ExternalDuration, *Response, error) {
+	path := "users/current/external_durations"
+
+	if err := duration.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	request, err := service.client.NewRequest("POST", path, duration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(ExternalDuration)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// CreateBulk validates every duration before sending any, then sends them in chunks and returns one result per duration, in input order.
+func (service *ExternalDurationsService) CreateBulk(ctx context.Context, durations []ExternalDurationsCreateOptions) ([]ExternalDurationsBulkResult, error) {
+	path := "users/current/external_durations.bulk"
+
+	for i := range durations {
+		if err := durations[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	responses, err := postBulk(ctx, service.client, path, durations, externalDurationsBulkLimit)
+
+	results := make([]ExternalDurationsBulkResult, 0, len(responses))
+	for _, r := range responses {
+		var body struct {
+			Data  *ExternalDurationsData `json:"data"`
+			Error string                 `json:"error"`
+		}
+		if err := json.Unmarshal(r.Body, &body); err != nil {
+			return results, err
+		}
+
+		results = append(results, ExternalDurationsBulkResult{StatusCode: r.StatusCode, Data: body.Data, Error: body.Error})
+	}
+
+	return results, err
+}
+
+func (service *ExternalDurationsService) DeleteBulk(ctx context.Context, userId string, date string, ids []string) error {
+	_, err := service.DeleteBulkWithResponse(ctx, userId, date, ids)
+	return err
+}
+
+// DeleteBulkWithResponse is like DeleteBulk, and also returns the API response.
+func (service *ExternalDurationsService) DeleteBulkWithResponse(ctx context.Context, userId string, date string, ids []string) (*Response, error) {
+	path := fmt.Sprintf("users/%v/external_durations.bulk", userId)
+
+	request, err := service.client.NewRequest("DELETE", path, &deleteBulkBody{Date: date, Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.DoWithResponse(ctx, request, nil)
+}
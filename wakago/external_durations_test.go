@@ -0,0 +1,220 @@
+package wakago
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestExternalDurations_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"branch": null,
+				"category": "meeting",
+				"created_at": "2022-10-27T10:22:05Z",
+				"end_time": 1666866121.5,
+				"entity": "Weekly sync",
+				"external_id": "calendar-123",
+				"id": "c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d",
+				"language": null,
+				"meta": null,
+				"project": "wakago",
+				"start_time": 1666862521.5,
+				"type": "app"
+			}
+		],
+		"start": "2022-10-26T15:00:00Z",
+		"end": "2022-10-27T14:59:59Z",
+		"timezone": "Asia/Tokyo"
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/external_durations"
+	httpmock.RegisterResponderWithQuery("GET", url, "date=2022-10-27&project=wakago", httpmock.NewStringResponder(200, dummyResponse))
+
+	project := "wakago"
+	client := NewClient(nil)
+	res, err := client.ExternalDurationsService.Get(context.Background(), "current", &ExternalDurationsGetOptions{Date: "2022-10-27", Project: &project})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := ExternalDurations{
+		Data: []ExternalDurationsData{
+			{
+				Id:         "c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d",
+				ExternalId: "calendar-123",
+				Entity:     "Weekly sync",
+				Type:       "app",
+				Category:   "meeting",
+				StartTime:  1666862521.5,
+				EndTime:    1666866121.5,
+				Project:    null.StringFrom("wakago"),
+				CreatedAt:  time.Date(2022, 10, 27, 10, 22, 5, 0, time.UTC),
+			},
+		},
+		Start:    time.Date(2022, 10, 26, 15, 00, 00, 0, time.UTC),
+		End:      time.Date(2022, 10, 27, 14, 59, 59, 0, time.UTC),
+		Timezone: "Asia/Tokyo",
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestExternalDurations_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": {
+			"category": "code reviewing",
+			"created_at": "2022-10-27T10:22:05Z",
+			"end_time": 1666866121.5,
+			"entity": "https://github.com/yamash723/wakago/pull/1",
+			"external_id": "review-1",
+			"id": "c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d",
+			"start_time": 1666862521.5,
+			"type": "url"
+		}
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/external_durations"
+	httpmock.RegisterResponder("POST", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, `{"external_id":"review-1","entity":"https://github.com/yamash723/wakago/pull/1","type":"url","start_time":1666862521.5,"end_time":1666866121.5,"category":"code reviewing"}`, string(body))
+		return httpmock.NewStringResponse(201, dummyResponse), nil
+	})
+
+	category := "code reviewing"
+	client := NewClient(nil)
+	res, err := client.ExternalDurationsService.Create(context.Background(), &ExternalDurationsCreateOptions{
+		ExternalId: "review-1",
+		Entity:     "https://github.com/yamash723/wakago/pull/1",
+		Type:       "url",
+		StartTime:  1666862521.5,
+		EndTime:    1666866121.5,
+		Category:   &category,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := ExternalDuration{
+		Data: ExternalDurationsData{
+			Id:         "c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d",
+			ExternalId: "review-1",
+			Entity:     "https://github.com/yamash723/wakago/pull/1",
+			Type:       "url",
+			Category:   "code reviewing",
+			StartTime:  1666862521.5,
+			EndTime:    1666866121.5,
+			CreatedAt:  time.Date(2022, 10, 27, 10, 22, 5, 0, time.UTC),
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestExternalDurations_Create_invalid(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := NewClient(nil)
+	category := "napping"
+
+	tests := []ExternalDurationsCreateOptions{
+		{ExternalId: "1", Entity: "entity", Type: "app", StartTime: 20, EndTime: 10},
+		{ExternalId: "2", Entity: "entity", Type: "app", StartTime: 10, EndTime: 10},
+		{ExternalId: "3", Entity: "entity", Type: "app", StartTime: 10, EndTime: 20, Category: &category},
+		{Entity: "entity", Type: "app", StartTime: 10, EndTime: 20},
+	}
+
+	for _, tt := range tests {
+		_, err := client.ExternalDurationsService.Create(context.Background(), &tt)
+		assert.NotNil(t, err)
+	}
+
+	_, err := client.ExternalDurationsService.Create(context.Background(), nil)
+	assert.NotNil(t, err)
+
+	_, err = client.ExternalDurationsService.CreateBulk(context.Background(), tests[2:3])
+	assert.NotNil(t, err)
+
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestExternalDurations_CreateBulk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/external_durations.bulk"
+	httpmock.RegisterResponder("POST", url, func(request *http.Request) (*http.Response, error) {
+		var sent []ExternalDurationsCreateOptions
+		if err := json.NewDecoder(request.Body).Decode(&sent); err != nil {
+			return nil, err
+		}
+
+		assert.Len(t, sent, 2)
+		return httpmock.NewStringResponse(202, `
+		{
+			"responses": [
+				[{"data": {"id": "c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d", "external_id": "meeting-1"}}, 201],
+				[{"error": "Duplicate external_id."}, 400]
+			]
+		}`), nil
+	})
+
+	client := NewClient(nil)
+	res, err := client.ExternalDurationsService.CreateBulk(context.Background(), []ExternalDurationsCreateOptions{
+		{ExternalId: "meeting-1", Entity: "Weekly sync", Type: "app", StartTime: 10, EndTime: 20},
+		{ExternalId: "meeting-1", Entity: "Weekly sync", Type: "app", StartTime: 30, EndTime: 40},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []ExternalDurationsBulkResult{
+		{StatusCode: 201, Data: &ExternalDurationsData{Id: "c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d", ExternalId: "meeting-1"}},
+		{StatusCode: 400, Error: "Duplicate external_id."},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Equal(t, expected, res)
+	assert.Nil(t, res[0].Err())
+	assert.NotNil(t, res[1].Err())
+}
+
+func TestExternalDurations_DeleteBulk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/external_durations.bulk"
+	httpmock.RegisterResponder("DELETE", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, `{"date":"2022-10-27","ids":["c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d"]}`, string(body))
+		return httpmock.NewStringResponse(204, ""), nil
+	})
+
+	client := NewClient(nil)
+	err := client.ExternalDurationsService.DeleteBulk(context.Background(), "current", "2022-10-27", []string{"c0ffee00-1234-4a5b-8c9d-0e1f2a3b4c5d"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,59 @@
+package wakago
+
+import "context"
+
+type FileExpertsService service
+
+type FileExperts struct {
+	Data []FileExpertsData `json:"data"`
+}
+
+type FileExpertsData struct {
+	User  FileExpertsUser  `json:"user"`
+	Total FileExpertsTotal `json:"total"`
+}
+
+type FileExpertsUser struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	LongName      string `json:"long_name"`
+	IsCurrentUser bool   `json:"is_current_user"`
+}
+
+type FileExpertsTotal struct {
+	Decimal      string  `json:"decimal"`
+	Digital      string  `json:"digital"`
+	Text         string  `json:"text"`
+	TotalSeconds float64 `json:"total_seconds"`
+}
+
+type FileExpertsGetOptions struct {
+	Entity           string `json:"entity"`
+	Project          string `json:"project"`
+	ProjectRootCount *int   `json:"project_root_count,omitempty"`
+}
+
+// Get returns the users who have spent the most time on the file.
+// The endpoint takes its parameters as a JSON body, so it is requested with POST.
+func (service *FileExpertsService) Get(ctx context.Context, opts *FileExpertsGetOptions) (*FileExperts, error) {
+	v, _, err := service.GetWithResponse(ctx, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *FileExpertsService) GetWithResponse(ctx context.Context, opts *FileExpertsGetOptions) (*FileExperts, *Response, error) {
+	path := "users/current/file_experts"
+
+	request, err := service.client.NewRequest("POST", path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(FileExperts)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
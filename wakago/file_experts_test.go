@@ -0,0 +1,78 @@
+package wakago
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileExperts_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"total": {
+					"decimal": "2.70",
+					"digital": "2:42",
+					"text": "2 hrs 42 mins",
+					"total_seconds": 9757.783427
+				},
+				"user": {
+					"id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					"is_current_user": true,
+					"long_name": "Shuhei Yamashita",
+					"name": "yamash723"
+				}
+			}
+		]
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/file_experts"
+	httpmock.RegisterResponder("POST", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, `{"entity":"wakago/wakago.go","project":"wakago","project_root_count":2}`, string(body))
+		assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		return httpmock.NewStringResponse(200, dummyResponse), nil
+	})
+
+	projectRootCount := 2
+	client := NewClient(nil)
+	res, err := client.FileExpertsService.Get(context.Background(), &FileExpertsGetOptions{
+		Entity:           "wakago/wakago.go",
+		Project:          "wakago",
+		ProjectRootCount: &projectRootCount,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := FileExperts{
+		Data: []FileExpertsData{
+			{
+				User: FileExpertsUser{
+					Id:            "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					Name:          "yamash723",
+					LongName:      "Shuhei Yamashita",
+					IsCurrentUser: true,
+				},
+				Total: FileExpertsTotal{
+					Decimal:      "2.70",
+					Digital:      "2:42",
+					Text:         "2 hrs 42 mins",
+					TotalSeconds: 9757.783427,
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
@@ -73,35 +73,47 @@ type GoalData struct {
 }
 
 func (service *GoalsService) GetAll(ctx context.Context, userId string) (*Goals, error) {
+	v, _, err := service.GetAllWithResponse(ctx, userId)
+	return v, err
+}
+
+// GetAllWithResponse is like GetAll, and also returns the API response.
+func (service *GoalsService) GetAllWithResponse(ctx context.Context, userId string) (*Goals, *Response, error) {
 	path := fmt.Sprintf("users/%v/goals", userId)
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	v := new(Goals)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
 }
 
 func (service *GoalsService) Get(ctx context.Context, userId string, goalId string) (*Goal, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, goalId)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *GoalsService) GetWithResponse(ctx context.Context, userId string, goalId string) (*Goal, *Response, error) {
 	path := fmt.Sprintf("users/%v/goals/%v", userId, goalId)
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	v := new(Goal)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
 }
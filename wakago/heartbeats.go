@@ -0,0 +1,210 @@
+package wakago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"gopkg.in/guregu/null.v4"
+)
+
+type HeartbeatsService service
+
+type Heartbeats struct {
+	Data     []HeartbeatsData `json:"data"`
+	Start    time.Time        `json:"start"`
+	End      time.Time        `json:"end"`
+	Timezone string           `json:"timezone"`
+}
+
+type HeartbeatsData struct {
+	Id            string    `json:"id"`
+	Entity        string    `json:"entity"`
+	Type          string    `json:"type"`
+	Category      string    `json:"category"`
+	Time          float64   `json:"time"`
+	Project       string    `json:"project"`
+	Branch        string    `json:"branch"`
+	Language      string    `json:"language"`
+	Dependencies  []string  `json:"dependencies"`
+	Lines         int       `json:"lines"`
+	Lineno        null.Int  `json:"lineno"`
+	Cursorpos     null.Int  `json:"cursorpos"`
+	IsWrite       bool      `json:"is_write"`
+	MachineNameId string    `json:"machine_name_id"`
+	UserAgentId   string    `json:"user_agent_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type HeartbeatsGetOptions struct {
+	Date string `url:"date"`
+}
+
+type HeartbeatsCreateOptions struct {
+	Entity           string   `json:"entity"`
+	Type             string   `json:"type"`
+	Time             float64  `json:"time"`
+	Category         *string  `json:"category,omitempty"`
+	Project          *string  `json:"project,omitempty"`
+	ProjectRootCount *int     `json:"project_root_count,omitempty"`
+	Branch           *string  `json:"branch,omitempty"`
+	Language         *string  `json:"language,omitempty"`
+	Dependencies     []string `json:"dependencies,omitempty"`
+	Lines            *int     `json:"lines,omitempty"`
+	LineAdditions    *int     `json:"line_additions,omitempty"`
+	LineDeletions    *int     `json:"line_deletions,omitempty"`
+	Lineno           *int     `json:"lineno,omitempty"`
+	Cursorpos        *int     `json:"cursorpos,omitempty"`
+	IsWrite          *bool    `json:"is_write,omitempty"`
+}
+
+type HeartbeatsCreated struct {
+	Data HeartbeatsCreatedData `json:"data"`
+}
+
+type HeartbeatsCreatedData struct {
+	Id     string  `json:"id"`
+	Entity string  `json:"entity"`
+	Type   string  `json:"type"`
+	Time   float64 `json:"time"`
+}
+
+// HeartbeatsBulkResult is the outcome of a single heartbeat sent with CreateBulk.
+// The bulk endpoint accepts the request as a whole, so each heartbeat carries its own status code.
+type HeartbeatsBulkResult struct {
+	StatusCode int
+	Data       *HeartbeatsCreatedData
+	Error      string
+}
+
+func (r HeartbeatsBulkResult) Err() error {
+	return bulkResultErr(r.StatusCode, r.Error)
+}
+
+// The maximum number of heartbeats the bulk endpoint accepts in one request.
+const heartbeatsBulkLimit = 25
+
+func (service *HeartbeatsService) Get(ctx context.Context, userId string, opts *HeartbeatsGetOptions) (*Heartbeats, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *HeartbeatsService) GetWithResponse(ctx context.Context, userId string, opts *HeartbeatsGetOptions) (*Heartbeats, *Response, error) {
+	path := fmt.Sprintf("users/%v/heartbeats", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Heartbeats)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *HeartbeatsService) Create(ctx context.Context, heartbeat *HeartbeatsCreateOptions) (*HeartbeatsCreated, error) {
+	v, _, err := service.CreateWithResponse(ctx, heartbeat)
+	return v, err
+}
+
+// CreateWithResponse is like Create, and also returns the API response.
+func (service *HeartbeatsService) CreateWithResponse(ctx context.Context, heartbeat *HeartbeatsCreateOptions) (*HeartbeatsCreated, *Response, error) {
+	path := "users/current/heartbeats"
+
+	request, err := service.client.NewRequest("POST", path, heartbeat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(HeartbeatsCreated)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// CreateBulk sends heartbeats in chunks the bulk endpoint accepts and returns one result per heartbeat, in input order.
+// An error is returned only when a whole request fails; rejected heartbeats are reported through their result.
+func (service *HeartbeatsService) CreateBulk(ctx context.Context, heartbeats []HeartbeatsCreateOptions) ([]HeartbeatsBulkResult, error) {
+	path := "users/current/heartbeats.bulk"
+
+	responses, err := postBulk(ctx, service.client, path, heartbeats, heartbeatsBulkLimit)
+
+	results := make([]HeartbeatsBulkResult, 0, len(responses))
+	for _, r := range responses {
+		var body struct {
+			Data  *HeartbeatsCreatedData `json:"data"`
+			Error string                 `json:"error"`
+		}
+		if err := json.Unmarshal(r.Body, &body); err != nil {
+			return results, err
+		}
+
+		results = append(results, HeartbeatsBulkResult{StatusCode: r.StatusCode, Data: body.Data, Error: body.Error})
+	}
+
+	return results, err
+}
+
+func (service *HeartbeatsService) DeleteBulk(ctx context.Context, userId string, date string, ids []string) error {
+	_, err := service.DeleteBulkWithResponse(ctx, userId, date, ids)
+	return err
+}
+
+// DeleteBulkWithResponse is like DeleteBulk, and also returns the API response.
+func (service *HeartbeatsService) DeleteBulkWithResponse(ctx context.Context, userId string, date string, ids []string) (*Response, error) {
+	path := fmt.Sprintf("users/%v/heartbeats.bulk", userId)
+
+	request, err := service.client.NewRequest("DELETE", path, &deleteBulkBody{Date: date, Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.DoWithResponse(ctx, request, nil)
+}
+
+// DeleteBulkDryRun returns the heartbeats on date that DeleteBulk would remove for ids, without deleting anything.
+func (service *HeartbeatsService) DeleteBulkDryRun(ctx context.Context, userId string, date string, ids []string) ([]HeartbeatsData, error) {
+	v, _, err := service.DeleteBulkDryRunWithResponse(ctx, userId, date, ids)
+	return v, err
+}
+
+// DeleteBulkDryRunWithResponse is like DeleteBulkDryRun, and also returns the API response.
+func (service *HeartbeatsService) DeleteBulkDryRunWithResponse(ctx context.Context, userId string, date string, ids []string) ([]HeartbeatsData, *Response, error) {
+	heartbeats, response, err := service.GetWithResponse(ctx, userId, &HeartbeatsGetOptions{Date: date})
+	if err != nil {
+		return nil, response, err
+	}
+
+	targets := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		targets[id] = true
+	}
+
+	matched := []HeartbeatsData{}
+	for _, heartbeat := range heartbeats.Data {
+		if targets[heartbeat.Id] {
+			matched = append(matched, heartbeat)
+		}
+	}
+
+	return matched, response, nil
+}
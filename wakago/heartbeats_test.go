@@ -0,0 +1,287 @@
+package wakago
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestHeartbeats_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"branch": "main",
+				"category": "coding",
+				"created_at": "2022-10-27T10:22:05Z",
+				"cursorpos": 1024,
+				"dependencies": [
+					"context",
+					"fmt"
+				],
+				"entity": "/home/yamash723/wakago/wakago/heartbeats.go",
+				"id": "d5ad4aab-3f1f-4ea4-b71c-d1a5bd6ba1a4",
+				"is_write": true,
+				"language": "Go",
+				"lineno": 42,
+				"lines": 68,
+				"machine_name_id": "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+				"project": "wakago",
+				"time": 1666866121.027658,
+				"type": "file",
+				"user_agent_id": "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d"
+			},
+			{
+				"branch": "main",
+				"category": "browsing",
+				"created_at": "2022-10-27T10:25:41Z",
+				"cursorpos": null,
+				"dependencies": [],
+				"entity": "wakatime.com",
+				"id": "0c6b6c59-5a8a-4d0d-8f2f-0f6d3f0f4b8d",
+				"is_write": false,
+				"language": "",
+				"lineno": null,
+				"lines": 0,
+				"machine_name_id": "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+				"project": "wakago",
+				"time": 1666866341.5,
+				"type": "domain",
+				"user_agent_id": "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d"
+			}
+		],
+		"start": "2022-10-26T15:00:00Z",
+		"end": "2022-10-27T14:59:59Z",
+		"timezone": "Asia/Tokyo"
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/heartbeats"
+	expectedQuery := "date=2022-10-27"
+	httpmock.RegisterResponderWithQuery("GET", url, expectedQuery, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	opts := HeartbeatsGetOptions{Date: "2022-10-27"}
+	res, err := client.HeartbeatsService.Get(context.Background(), "current", &opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Heartbeats{
+		Data: []HeartbeatsData{
+			{
+				Id:            "d5ad4aab-3f1f-4ea4-b71c-d1a5bd6ba1a4",
+				Entity:        "/home/yamash723/wakago/wakago/heartbeats.go",
+				Type:          "file",
+				Category:      "coding",
+				Time:          1666866121.027658,
+				Project:       "wakago",
+				Branch:        "main",
+				Language:      "Go",
+				Dependencies:  []string{"context", "fmt"},
+				Lines:         68,
+				Lineno:        null.IntFrom(42),
+				Cursorpos:     null.IntFrom(1024),
+				IsWrite:       true,
+				MachineNameId: "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+				UserAgentId:   "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d",
+				CreatedAt:     time.Date(2022, 10, 27, 10, 22, 5, 0, time.UTC),
+			},
+			{
+				Id:            "0c6b6c59-5a8a-4d0d-8f2f-0f6d3f0f4b8d",
+				Entity:        "wakatime.com",
+				Type:          "domain",
+				Category:      "browsing",
+				Time:          1666866341.5,
+				Project:       "wakago",
+				Branch:        "main",
+				Language:      "",
+				Dependencies:  []string{},
+				Lines:         0,
+				Lineno:        null.Int{},
+				Cursorpos:     null.Int{},
+				IsWrite:       false,
+				MachineNameId: "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+				UserAgentId:   "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d",
+				CreatedAt:     time.Date(2022, 10, 27, 10, 25, 41, 0, time.UTC),
+			},
+		},
+		Start:    time.Date(2022, 10, 26, 15, 00, 00, 0, time.UTC),
+		End:      time.Date(2022, 10, 27, 14, 59, 59, 0, time.UTC),
+		Timezone: "Asia/Tokyo",
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestHeartbeats_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": {
+			"entity": "/home/yamash723/wakago/wakago/heartbeats.go",
+			"id": "d5ad4aab-3f1f-4ea4-b71c-d1a5bd6ba1a4",
+			"time": 1666866121.027658,
+			"type": "file"
+		}
+	}`
+
+	expectedBody := `{"entity":"/home/yamash723/wakago/wakago/heartbeats.go","type":"file","time":1666866121.027658,"project":"wakago","is_write":true}`
+
+	url := "https://wakatime.com/api/v1/users/current/heartbeats"
+	httpmock.RegisterResponder("POST", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, expectedBody, string(body))
+		return httpmock.NewStringResponse(201, dummyResponse), nil
+	})
+
+	project, isWrite := "wakago", true
+	client := NewClient(nil)
+	res, err := client.HeartbeatsService.Create(context.Background(), &HeartbeatsCreateOptions{
+		Entity:  "/home/yamash723/wakago/wakago/heartbeats.go",
+		Type:    "file",
+		Time:    1666866121.027658,
+		Project: &project,
+		IsWrite: &isWrite,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := HeartbeatsCreated{
+		Data: HeartbeatsCreatedData{
+			Id:     "d5ad4aab-3f1f-4ea4-b71c-d1a5bd6ba1a4",
+			Entity: "/home/yamash723/wakago/wakago/heartbeats.go",
+			Type:   "file",
+			Time:   1666866121.027658,
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestHeartbeats_CreateBulk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	heartbeats := make([]HeartbeatsCreateOptions, 27)
+	for i := range heartbeats {
+		heartbeats[i] = HeartbeatsCreateOptions{Entity: fmt.Sprintf("file%v.go", i), Type: "file", Time: float64(1666866121 + i)}
+	}
+
+	// Every heartbeat is created except the first one of each request.
+	var chunkSizes []int
+	url := "https://wakatime.com/api/v1/users/current/heartbeats.bulk"
+	httpmock.RegisterResponder("POST", url, func(request *http.Request) (*http.Response, error) {
+		var sent []HeartbeatsCreateOptions
+		if err := json.NewDecoder(request.Body).Decode(&sent); err != nil {
+			return nil, err
+		}
+		chunkSizes = append(chunkSizes, len(sent))
+
+		responses := make([]interface{}, len(sent))
+		for i, h := range sent {
+			if i == 0 {
+				responses[i] = []interface{}{map[string]string{"error": "Invalid entity."}, 400}
+				continue
+			}
+
+			data := map[string]interface{}{"id": h.Entity, "entity": h.Entity, "type": h.Type, "time": h.Time}
+			responses[i] = []interface{}{map[string]interface{}{"data": data}, 201}
+		}
+
+		return httpmock.NewJsonResponse(202, map[string]interface{}{"responses": responses})
+	})
+
+	client := NewClient(nil)
+	res, err := client.HeartbeatsService.CreateBulk(context.Background(), heartbeats)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	assert.Equal(t, []int{25, 2}, chunkSizes)
+	assert.Len(t, res, 27)
+
+	assert.Equal(t, HeartbeatsBulkResult{StatusCode: 400, Error: "Invalid entity."}, res[0])
+	assert.NotNil(t, res[0].Err())
+	assert.Equal(t, HeartbeatsBulkResult{StatusCode: 400, Error: "Invalid entity."}, res[25])
+
+	expected := HeartbeatsBulkResult{
+		StatusCode: 201,
+		Data:       &HeartbeatsCreatedData{Id: "file26.go", Entity: "file26.go", Type: "file", Time: 1666866147},
+	}
+	assert.Equal(t, expected, res[26])
+	assert.Nil(t, res[26].Err())
+}
+
+func TestHeartbeats_DeleteBulk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	expectedBody := `{"date":"2022-10-27","ids":["d5ad4aab-3f1f-4ea4-b71c-d1a5bd6ba1a4"]}`
+
+	url := "https://wakatime.com/api/v1/users/current/heartbeats.bulk"
+	httpmock.RegisterResponder("DELETE", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, expectedBody, string(body))
+		return httpmock.NewStringResponse(204, ""), nil
+	})
+
+	client := NewClient(nil)
+	err := client.HeartbeatsService.DeleteBulk(context.Background(), "current", "2022-10-27", []string{"d5ad4aab-3f1f-4ea4-b71c-d1a5bd6ba1a4"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestHeartbeats_DeleteBulkDryRun(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{"id": "d5ad4aab-3f1f-4ea4-b71c-d1a5bd6ba1a4", "entity": "heartbeats.go", "type": "file", "time": 1666866121},
+			{"id": "0c6b6c59-5a8a-4d0d-8f2f-0f6d3f0f4b8d", "entity": "wakatime.com", "type": "domain", "time": 1666866341}
+		],
+		"start": "2022-10-26T15:00:00Z",
+		"end": "2022-10-27T14:59:59Z",
+		"timezone": "Asia/Tokyo"
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/heartbeats"
+	httpmock.RegisterResponderWithQuery("GET", url, "date=2022-10-27", httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.HeartbeatsService.DeleteBulkDryRun(context.Background(), "current", "2022-10-27", []string{"0c6b6c59-5a8a-4d0d-8f2f-0f6d3f0f4b8d", "unknown"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []HeartbeatsData{
+		{Id: "0c6b6c59-5a8a-4d0d-8f2f-0f6d3f0f4b8d", Entity: "wakatime.com", Type: "domain", Time: 1666866341},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["DELETE https://wakatime.com/api/v1/users/current/heartbeats.bulk"])
+	assert.EqualValues(t, expected, res)
+}
@@ -0,0 +1,347 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+type InsightsService service
+
+type InsightType string
+
+const (
+	InsightTypeWeekdays         InsightType = "weekdays"
+	InsightTypeDays             InsightType = "days"
+	InsightTypeBestDay          InsightType = "best_day"
+	InsightTypeDailyAverage     InsightType = "daily_average"
+	InsightTypeProjects         InsightType = "projects"
+	InsightTypeLanguages        InsightType = "languages"
+	InsightTypeEditors          InsightType = "editors"
+	InsightTypeCategories       InsightType = "categories"
+	InsightTypeMachines         InsightType = "machines"
+	InsightTypeOperatingSystems InsightType = "operating_systems"
+)
+
+// InsightsCommon holds the fields shared by every insight type.
+type InsightsCommon struct {
+	UserId             string    `json:"user_id"`
+	Range              string    `json:"range"`
+	HumanReadableRange string    `json:"human_readable_range"`
+	Start              time.Time `json:"start"`
+	End                time.Time `json:"end"`
+	Timezone           string    `json:"timezone"`
+	Timeout            int       `json:"timeout"`
+	WritesOnly         bool      `json:"writes_only"`
+	Status             string    `json:"status"`
+	IsUpToDate         bool      `json:"is_up_to_date"`
+	PercentCalculated  int       `json:"percent_calculated"`
+	IsIncludingToday   bool      `json:"is_including_today"`
+}
+
+type InsightsItem struct {
+	Name         string  `json:"name"`
+	TotalSeconds float64 `json:"total_seconds"`
+}
+
+type InsightsMachineItem struct {
+	InsightsItem
+	MachineNameId string `json:"machine_name_id"`
+}
+
+type InsightsWeekdays struct {
+	Data InsightsWeekdaysData `json:"data"`
+}
+
+type InsightsWeekdaysData struct {
+	InsightsCommon
+	Weekdays []InsightsWeekday `json:"weekdays"`
+}
+
+type InsightsWeekday struct {
+	Name                 string  `json:"name"`
+	Total                float64 `json:"total"`
+	Average              float64 `json:"average"`
+	Count                int     `json:"count"`
+	HumanReadableTotal   string  `json:"human_readable_total"`
+	HumanReadableAverage string  `json:"human_readable_average"`
+}
+
+type InsightsDays struct {
+	Data InsightsDaysData `json:"data"`
+}
+
+type InsightsDaysData struct {
+	InsightsCommon
+	Days []InsightsDay `json:"days"`
+}
+
+type InsightsDay struct {
+	Date  string  `json:"date"`
+	Total float64 `json:"total"`
+}
+
+type InsightsBestDay struct {
+	Data InsightsBestDayData `json:"data"`
+}
+
+type InsightsBestDayData struct {
+	InsightsCommon
+	BestDay StatsBestDay `json:"best_day"`
+}
+
+type InsightsDailyAverage struct {
+	Data InsightsDailyAverageData `json:"data"`
+}
+
+type InsightsDailyAverageData struct {
+	InsightsCommon
+	DailyAverage                                    float64 `json:"daily_average"`
+	DailyAverageIncludingOtherLanguage              float64 `json:"daily_average_including_other_language"`
+	HumanReadableDailyAverage                       string  `json:"human_readable_daily_average"`
+	HumanReadableDailyAverageIncludingOtherLanguage string  `json:"human_readable_daily_average_including_other_language"`
+	Holidays                                        int     `json:"holidays"`
+	DaysIncludingHolidays                           int     `json:"days_including_holidays"`
+	DaysMinusHolidays                               int     `json:"days_minus_holidays"`
+}
+
+type InsightsProjects struct {
+	Data InsightsProjectsData `json:"data"`
+}
+
+type InsightsProjectsData struct {
+	InsightsCommon
+	Projects []InsightsItem `json:"projects"`
+}
+
+type InsightsLanguages struct {
+	Data InsightsLanguagesData `json:"data"`
+}
+
+type InsightsLanguagesData struct {
+	InsightsCommon
+	Languages []InsightsItem `json:"languages"`
+}
+
+type InsightsEditors struct {
+	Data InsightsEditorsData `json:"data"`
+}
+
+type InsightsEditorsData struct {
+	InsightsCommon
+	Editors []InsightsItem `json:"editors"`
+}
+
+type InsightsCategories struct {
+	Data InsightsCategoriesData `json:"data"`
+}
+
+type InsightsCategoriesData struct {
+	InsightsCommon
+	Categories []InsightsItem `json:"categories"`
+}
+
+type InsightsMachines struct {
+	Data InsightsMachinesData `json:"data"`
+}
+
+type InsightsMachinesData struct {
+	InsightsCommon
+	Machines []InsightsMachineItem `json:"machines"`
+}
+
+type InsightsOperatingSystems struct {
+	Data InsightsOperatingSystemsData `json:"data"`
+}
+
+type InsightsOperatingSystemsData struct {
+	InsightsCommon
+	OperatingSystems []InsightsItem `json:"operating_systems"`
+}
+
+type InsightsGetOptions struct {
+	Timeout    *int  `url:"timeout,omitempty"`
+	WritesOnly *bool `url:"writes_only,omitempty"`
+}
+
+func (service *InsightsService) Weekdays(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsWeekdays, error) {
+	v, _, err := service.WeekdaysWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// WeekdaysWithResponse is like Weekdays, and also returns the API response.
+func (service *InsightsService) WeekdaysWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsWeekdays, *Response, error) {
+	v := new(InsightsWeekdays)
+	response, err := service.get(ctx, userId, InsightTypeWeekdays, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) Days(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsDays, error) {
+	v, _, err := service.DaysWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// DaysWithResponse is like Days, and also returns the API response.
+func (service *InsightsService) DaysWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsDays, *Response, error) {
+	v := new(InsightsDays)
+	response, err := service.get(ctx, userId, InsightTypeDays, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) BestDay(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsBestDay, error) {
+	v, _, err := service.BestDayWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// BestDayWithResponse is like BestDay, and also returns the API response.
+func (service *InsightsService) BestDayWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsBestDay, *Response, error) {
+	v := new(InsightsBestDay)
+	response, err := service.get(ctx, userId, InsightTypeBestDay, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) DailyAverage(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsDailyAverage, error) {
+	v, _, err := service.DailyAverageWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// DailyAverageWithResponse is like DailyAverage, and also returns the API response.
+func (service *InsightsService) DailyAverageWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsDailyAverage, *Response, error) {
+	v := new(InsightsDailyAverage)
+	response, err := service.get(ctx, userId, InsightTypeDailyAverage, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) Projects(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsProjects, error) {
+	v, _, err := service.ProjectsWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// ProjectsWithResponse is like Projects, and also returns the API response.
+func (service *InsightsService) ProjectsWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsProjects, *Response, error) {
+	v := new(InsightsProjects)
+	response, err := service.get(ctx, userId, InsightTypeProjects, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) Languages(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsLanguages, error) {
+	v, _, err := service.LanguagesWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// LanguagesWithResponse is like Languages, and also returns the API response.
+func (service *InsightsService) LanguagesWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsLanguages, *Response, error) {
+	v := new(InsightsLanguages)
+	response, err := service.get(ctx, userId, InsightTypeLanguages, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) Editors(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsEditors, error) {
+	v, _, err := service.EditorsWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// EditorsWithResponse is like Editors, and also returns the API response.
+func (service *InsightsService) EditorsWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsEditors, *Response, error) {
+	v := new(InsightsEditors)
+	response, err := service.get(ctx, userId, InsightTypeEditors, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) Categories(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsCategories, error) {
+	v, _, err := service.CategoriesWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// CategoriesWithResponse is like Categories, and also returns the API response.
+func (service *InsightsService) CategoriesWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsCategories, *Response, error) {
+	v := new(InsightsCategories)
+	response, err := service.get(ctx, userId, InsightTypeCategories, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) Machines(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsMachines, error) {
+	v, _, err := service.MachinesWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// MachinesWithResponse is like Machines, and also returns the API response.
+func (service *InsightsService) MachinesWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsMachines, *Response, error) {
+	v := new(InsightsMachines)
+	response, err := service.get(ctx, userId, InsightTypeMachines, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) OperatingSystems(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsOperatingSystems, error) {
+	v, _, err := service.OperatingSystemsWithResponse(ctx, userId, insightRange, opts)
+	return v, err
+}
+
+// OperatingSystemsWithResponse is like OperatingSystems, and also returns the API response.
+func (service *InsightsService) OperatingSystemsWithResponse(ctx context.Context, userId string, insightRange StatsRange, opts *InsightsGetOptions) (*InsightsOperatingSystems, *Response, error) {
+	v := new(InsightsOperatingSystems)
+	response, err := service.get(ctx, userId, InsightTypeOperatingSystems, insightRange, opts, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *InsightsService) get(ctx context.Context, userId string, insightType InsightType, insightRange StatsRange, opts *InsightsGetOptions, v interface{}) (*Response, error) {
+	path := fmt.Sprintf("users/%v/insights/%v/%v", userId, insightType, insightRange)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	return service.client.DoWithResponse(ctx, request, v)
+}
@@ -0,0 +1,186 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsights_Weekdays(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": {
+			"end": "2022-10-27T14:59:59Z",
+			"human_readable_range": "last 7 days",
+			"is_including_today": true,
+			"is_up_to_date": true,
+			"percent_calculated": 100,
+			"range": "last_7_days",
+			"start": "2022-10-20T15:00:00Z",
+			"status": "ok",
+			"timeout": 15,
+			"timezone": "Asia/Tokyo",
+			"user_id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			"weekdays": [
+				{
+					"average": 4745.996201,
+					"count": 1,
+					"human_readable_average": "1 hr 19 mins",
+					"human_readable_total": "1 hr 19 mins",
+					"name": "Monday",
+					"total": 4745.996201
+				}
+			],
+			"writes_only": false
+		}
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/insights/weekdays/last_7_days"
+	httpmock.RegisterResponderWithQuery("GET", url, "timeout=15", httpmock.NewStringResponder(200, dummyResponse))
+
+	timeout := 15
+	client := NewClient(nil)
+	res, err := client.InsightsService.Weekdays(context.Background(), "current", StatsRangeLast7Days, &InsightsGetOptions{Timeout: &timeout})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := InsightsWeekdays{
+		Data: InsightsWeekdaysData{
+			InsightsCommon: InsightsCommon{
+				UserId:             "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+				Range:              "last_7_days",
+				HumanReadableRange: "last 7 days",
+				Start:              time.Date(2022, 10, 20, 15, 0, 0, 0, time.UTC),
+				End:                time.Date(2022, 10, 27, 14, 59, 59, 0, time.UTC),
+				Timezone:           "Asia/Tokyo",
+				Timeout:            15,
+				Status:             "ok",
+				IsUpToDate:         true,
+				PercentCalculated:  100,
+				IsIncludingToday:   true,
+			},
+			Weekdays: []InsightsWeekday{
+				{
+					Name:                 "Monday",
+					Total:                4745.996201,
+					Average:              4745.996201,
+					Count:                1,
+					HumanReadableTotal:   "1 hr 19 mins",
+					HumanReadableAverage: "1 hr 19 mins",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestInsights_BestDay(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": {
+			"best_day": {
+				"date": "2022-10-25",
+				"text": "2 hrs 42 mins",
+				"total_seconds": 9757.783427
+			},
+			"is_up_to_date": true,
+			"percent_calculated": 100,
+			"range": "2022",
+			"status": "ok"
+		}
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/insights/best_day/2022"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.InsightsService.BestDay(context.Background(), "current", StatsRangeYear(2022), nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := InsightsBestDay{
+		Data: InsightsBestDayData{
+			InsightsCommon: InsightsCommon{
+				Range:             "2022",
+				Status:            "ok",
+				IsUpToDate:        true,
+				PercentCalculated: 100,
+			},
+			BestDay: StatsBestDay{
+				Date:         "2022-10-25",
+				Text:         "2 hrs 42 mins",
+				TotalSeconds: 9757.783427,
+			},
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestInsights_Machines(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": {
+			"is_up_to_date": true,
+			"machines": [
+				{
+					"machine_name_id": "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+					"name": "macbook",
+					"total_seconds": 46101.533187
+				}
+			],
+			"percent_calculated": 100,
+			"range": "last_30_days",
+			"status": "ok"
+		}
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/insights/machines/last_30_days"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.InsightsService.Machines(context.Background(), "current", StatsRangeLast30Days, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := InsightsMachines{
+		Data: InsightsMachinesData{
+			InsightsCommon: InsightsCommon{
+				Range:             "last_30_days",
+				Status:            "ok",
+				IsUpToDate:        true,
+				PercentCalculated: 100,
+			},
+			Machines: []InsightsMachineItem{
+				{
+					InsightsItem:  InsightsItem{Name: "macbook", TotalSeconds: 46101.533187},
+					MachineNameId: "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
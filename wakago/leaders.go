@@ -0,0 +1,133 @@
+package wakago
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"gopkg.in/guregu/null.v4"
+)
+
+type LeadersService service
+
+type Leaders struct {
+	Data        []LeadersData `json:"data"`
+	CurrentUser *LeadersData  `json:"current_user"`
+	Language    null.String   `json:"language"`
+	IsHireable  bool          `json:"is_hireable"`
+	CountryCode null.String   `json:"country_code"`
+	ModifiedAt  time.Time     `json:"modified_at"`
+	Range       LeadersRange  `json:"range"`
+	Timeout     int           `json:"timeout"`
+	WritesOnly  bool          `json:"writes_only"`
+	Page        int           `json:"page"`
+	TotalPages  int           `json:"total_pages"`
+}
+
+type LeadersData struct {
+	Rank         int                 `json:"rank"`
+	RunningTotal LeadersRunningTotal `json:"running_total"`
+	User         LeadersUser         `json:"user"`
+}
+
+type LeadersRunningTotal struct {
+	TotalSeconds              float64        `json:"total_seconds"`
+	HumanReadableTotal        string         `json:"human_readable_total"`
+	DailyAverage              float64        `json:"daily_average"`
+	HumanReadableDailyAverage string         `json:"human_readable_daily_average"`
+	Languages                 []InsightsItem `json:"languages"`
+}
+
+type LeadersUser struct {
+	Id                   string       `json:"id"`
+	Email                null.String  `json:"email"`
+	Username             null.String  `json:"username"`
+	FullName             null.String  `json:"full_name"`
+	DisplayName          string       `json:"display_name"`
+	Website              null.String  `json:"website"`
+	HumanReadableWebsite null.String  `json:"human_readable_website"`
+	Photo                string       `json:"photo"`
+	PhotoPublic          bool         `json:"photo_public"`
+	IsEmailPublic        bool         `json:"is_email_public"`
+	IsHireable           bool         `json:"is_hireable"`
+	City                 *LeadersCity `json:"city"`
+}
+
+type LeadersCity struct {
+	CountryCode string `json:"country_code"`
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	Title       string `json:"title"`
+}
+
+type LeadersRange struct {
+	StartDate string `json:"start_date"`
+	StartText string `json:"start_text"`
+	EndDate   string `json:"end_date"`
+	EndText   string `json:"end_text"`
+	Name      string `json:"name"`
+	Text      string `json:"text"`
+}
+
+type LeadersGetOptions struct {
+	Language    *string `url:"language,omitempty"`
+	IsHireable  *bool   `url:"is_hireable,omitempty"`
+	CountryCode *string `url:"country_code,omitempty"`
+	Page        *int    `url:"page,omitempty"`
+}
+
+func (service *LeadersService) Get(ctx context.Context, opts *LeadersGetOptions) (*Leaders, error) {
+	v, _, err := service.GetWithResponse(ctx, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *LeadersService) GetWithResponse(ctx context.Context, opts *LeadersGetOptions) (*Leaders, *Response, error) {
+	path := "leaders"
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Leaders)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// Iterate returns an iterator over every page of leaders, starting at opts.Page when it is set.
+func (service *LeadersService) Iterate(opts *LeadersGetOptions) *PageIterator[Leaders] {
+	o := LeadersGetOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	start := 1
+	if o.Page != nil {
+		start = *o.Page
+	}
+
+	return newPageIterator(start, func(ctx context.Context, page int) (*Leaders, *Response, int, error) {
+		o.Page = &page
+
+		v, response, err := service.GetWithResponse(ctx, &o)
+		if err != nil {
+			return nil, response, 0, err
+		}
+
+		return v, response, nextPage(page, v.TotalPages), nil
+	})
+}
@@ -0,0 +1,199 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestLeaders_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"current_user": null,
+		"data": [
+			{
+				"rank": 1,
+				"running_total": {
+					"daily_average": 43200.5,
+					"human_readable_daily_average": "12 hrs",
+					"human_readable_total": "84 hrs",
+					"languages": [
+						{
+							"name": "Go",
+							"total_seconds": 302403.5
+						}
+					],
+					"total_seconds": 302403.5
+				},
+				"user": {
+					"city": {
+						"country_code": "JP",
+						"name": "Tokyo",
+						"state": "Tokyo",
+						"title": "Tokyo, Japan"
+					},
+					"display_name": "yamash723",
+					"email": null,
+					"full_name": "Shuhei Yamashita",
+					"human_readable_website": "github.com/yamash723",
+					"id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					"is_email_public": false,
+					"is_hireable": true,
+					"photo": "https://wakatime.com/photo/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					"photo_public": true,
+					"username": "yamash723",
+					"website": "https://github.com/yamash723"
+				}
+			}
+		],
+		"country_code": "JP",
+		"is_hireable": true,
+		"language": "Go",
+		"modified_at": "2022-10-27T10:30:16Z",
+		"page": 1,
+		"range": {
+			"end_date": "2022-10-27",
+			"end_text": "Today",
+			"name": "last_7_days",
+			"start_date": "2022-10-21",
+			"start_text": "Fri Oct 21st 2022",
+			"text": "Last 7 Days"
+		},
+		"timeout": 15,
+		"total_pages": 3,
+		"writes_only": false
+	}`
+
+	url := "https://wakatime.com/api/v1/leaders"
+	language, isHireable, countryCode, page := "Go", true, "JP", 1
+
+	opts := LeadersGetOptions{
+		Language:    &language,
+		IsHireable:  &isHireable,
+		CountryCode: &countryCode,
+		Page:        &page,
+	}
+	qv, err := query.Values(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedQuery := qv.Encode()
+	httpmock.RegisterResponderWithQuery("GET", url, expectedQuery, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.LeadersService.Get(context.Background(), &opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Leaders{
+		Data: []LeadersData{
+			{
+				Rank: 1,
+				RunningTotal: LeadersRunningTotal{
+					TotalSeconds:              302403.5,
+					HumanReadableTotal:        "84 hrs",
+					DailyAverage:              43200.5,
+					HumanReadableDailyAverage: "12 hrs",
+					Languages:                 []InsightsItem{{Name: "Go", TotalSeconds: 302403.5}},
+				},
+				User: LeadersUser{
+					Id:                   "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					Email:                null.String{},
+					Username:             null.StringFrom("yamash723"),
+					FullName:             null.StringFrom("Shuhei Yamashita"),
+					DisplayName:          "yamash723",
+					Website:              null.StringFrom("https://github.com/yamash723"),
+					HumanReadableWebsite: null.StringFrom("github.com/yamash723"),
+					Photo:                "https://wakatime.com/photo/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					PhotoPublic:          true,
+					IsEmailPublic:        false,
+					IsHireable:           true,
+					City: &LeadersCity{
+						CountryCode: "JP",
+						Name:        "Tokyo",
+						State:       "Tokyo",
+						Title:       "Tokyo, Japan",
+					},
+				},
+			},
+		},
+		CurrentUser: nil,
+		Language:    null.StringFrom("Go"),
+		IsHireable:  true,
+		CountryCode: null.StringFrom("JP"),
+		ModifiedAt:  time.Date(2022, 10, 27, 10, 30, 16, 0, time.UTC),
+		Range: LeadersRange{
+			StartDate: "2022-10-21",
+			StartText: "Fri Oct 21st 2022",
+			EndDate:   "2022-10-27",
+			EndText:   "Today",
+			Name:      "last_7_days",
+			Text:      "Last 7 Days",
+		},
+		Timeout:    15,
+		Page:       1,
+		TotalPages: 3,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestLeaders_Iterate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/leaders"
+	httpmock.RegisterResponder("GET", url, func(request *http.Request) (*http.Response, error) {
+		assert.Equal(t, "Go", request.URL.Query().Get("language"))
+
+		page := request.URL.Query().Get("page")
+		body := fmt.Sprintf(`{"data": [{"rank": %v}], "page": %v, "total_pages": 3}`, page, page)
+		return httpmock.NewStringResponse(200, body), nil
+	})
+
+	language := "Go"
+	client := NewClient(nil)
+	it := client.LeadersService.Iterate(&LeadersGetOptions{Language: &language})
+
+	ranks := []int{}
+	for it.Next(context.Background()) {
+		ranks = append(ranks, it.Page().Data[0].Rank)
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3}, ranks)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestLeaders_Iterate_pageOmitted(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/leaders", httpmock.NewStringResponder(200, `{"data": [], "total_pages": 3}`))
+
+	client := NewClient(nil)
+	it := client.LeadersService.Iterate(nil)
+
+	pages := 0
+	for it.Next(context.Background()) && pages < 10 {
+		pages++
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,60 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type MachineNamesService service
+
+type MachineNames struct {
+	Data       []MachineNamesData `json:"data"`
+	Total      int                `json:"total"`
+	TotalPages int                `json:"total_pages"`
+}
+
+type MachineNamesData struct {
+	Id         string      `json:"id"`
+	Name       string      `json:"name"`
+	Value      string      `json:"value"`
+	Ip         null.String `json:"ip"`
+	Timezone   null.String `json:"timezone"`
+	LastSeenAt null.Time   `json:"last_seen_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// ById returns the machines keyed by the machine_name_id used in heartbeats and durations.
+func (m *MachineNames) ById() map[string]MachineNamesData {
+	machines := make(map[string]MachineNamesData, len(m.Data))
+	for _, machine := range m.Data {
+		machines[machine.Id] = machine
+	}
+
+	return machines
+}
+
+func (service *MachineNamesService) GetAll(ctx context.Context, userId string) (*MachineNames, error) {
+	v, _, err := service.GetAllWithResponse(ctx, userId)
+	return v, err
+}
+
+// GetAllWithResponse is like GetAll, and also returns the API response.
+func (service *MachineNamesService) GetAllWithResponse(ctx context.Context, userId string) (*MachineNames, *Response, error) {
+	path := fmt.Sprintf("users/%v/machine_names", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(MachineNames)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
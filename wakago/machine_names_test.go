@@ -0,0 +1,62 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestMachineNames_GetAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"created_at": "2022-10-13T01:22:05Z",
+				"id": "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+				"ip": "192.0.2.1",
+				"last_seen_at": "2022-11-01T11:05:17Z",
+				"name": "macbook",
+				"timezone": "Asia/Tokyo",
+				"value": "macbook.local"
+			}
+		],
+		"total": 1,
+		"total_pages": 1
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/machine_names"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.MachineNamesService.GetAll(context.Background(), "current")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machine := MachineNamesData{
+		Id:         "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+		Name:       "macbook",
+		Value:      "macbook.local",
+		Ip:         null.StringFrom("192.0.2.1"),
+		Timezone:   null.StringFrom("Asia/Tokyo"),
+		LastSeenAt: null.TimeFrom(time.Date(2022, 11, 1, 11, 5, 17, 0, time.UTC)),
+		CreatedAt:  time.Date(2022, 10, 13, 1, 22, 5, 0, time.UTC),
+	}
+	expected := MachineNames{
+		Data:       []MachineNamesData{machine},
+		Total:      1,
+		TotalPages: 1,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+	assert.Equal(t, map[string]MachineNamesData{machine.Id: machine}, res.ById())
+}
@@ -30,18 +30,24 @@ type MetaIPDescriptions struct {
 }
 
 func (service *MetaService) Get(ctx context.Context) (*Meta, error) {
+	v, _, err := service.GetWithResponse(ctx)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *MetaService) GetWithResponse(ctx context.Context) (*Meta, *Response, error) {
 	path := "meta"
 
 	request, err := service.client.NewRequest("GET", path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	v := new(Meta)
-	_, err = service.client.Do(ctx, request, v)
+	response, err := service.client.DoWithResponse(ctx, request, v)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
-	return v, nil
+	return v, response, nil
 }
@@ -0,0 +1,148 @@
+package wakago
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// MetadataRegistry caches the program languages and editors metadata in process,
+// so their colors can be looked up on every render without calling the API.
+// It is loaded on first use and is safe for concurrent use.
+type MetadataRegistry struct {
+	client *Client
+
+	// loadMu is held while fetching, so concurrent lookups share a single load.
+	loadMu sync.Mutex
+
+	mu        sync.Mutex
+	loaded    bool
+	languages map[string]ProgramLanguagesData
+	editors   map[string]EditorsData
+}
+
+func NewMetadataRegistry(client *Client) *MetadataRegistry {
+	return &MetadataRegistry{client: client}
+}
+
+// Load fetches the languages and editors, replacing anything cached before.
+func (r *MetadataRegistry) Load(ctx context.Context) error {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+
+	return r.load(ctx)
+}
+
+func (r *MetadataRegistry) load(ctx context.Context) error {
+	languages, err := r.client.ProgramLanguagesService.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	editors, err := r.client.EditorsService.Get(ctx, &EditorsGetOptions{Unreleased: true})
+	if err != nil {
+		return err
+	}
+
+	languagesByName := make(map[string]ProgramLanguagesData, len(languages.Data))
+	for _, language := range languages.Data {
+		languagesByName[registryKey(language.ID)] = language
+		languagesByName[registryKey(language.Name)] = language
+	}
+
+	editorsByName := make(map[string]EditorsData, len(editors.Data))
+	for _, editor := range editors.Data {
+		editorsByName[registryKey(editor.ID)] = editor
+		editorsByName[registryKey(editor.Name)] = editor
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.languages = languagesByName
+	r.editors = editorsByName
+	r.loaded = true
+
+	return nil
+}
+
+// Language looks a language up by id or name, ignoring case. It returns nil for unknown languages.
+func (r *MetadataRegistry) Language(ctx context.Context, name string) (*ProgramLanguagesData, error) {
+	if err := r.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	language, ok := r.languages[registryKey(name)]
+	if !ok {
+		return nil, nil
+	}
+
+	return &language, nil
+}
+
+// Editor looks an editor up by id or name, ignoring case. It returns nil for unknown editors.
+func (r *MetadataRegistry) Editor(ctx context.Context, name string) (*EditorsData, error) {
+	if err := r.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	editor, ok := r.editors[registryKey(name)]
+	if !ok {
+		return nil, nil
+	}
+
+	return &editor, nil
+}
+
+// LanguageColor returns the color WakaTime uses for the language, or "" when it has none.
+func (r *MetadataRegistry) LanguageColor(ctx context.Context, name string) (string, error) {
+	language, err := r.Language(ctx, name)
+	if err != nil || language == nil {
+		return "", err
+	}
+
+	return language.Color.ValueOrZero(), nil
+}
+
+// EditorColor returns the color WakaTime uses for the editor, or "" when it has none.
+func (r *MetadataRegistry) EditorColor(ctx context.Context, name string) (string, error) {
+	editor, err := r.Editor(ctx, name)
+	if err != nil || editor == nil {
+		return "", err
+	}
+
+	return editor.Color, nil
+}
+
+// ensureLoaded loads the registry unless an earlier load succeeded. Lookups waiting on a failed load try again.
+func (r *MetadataRegistry) ensureLoaded(ctx context.Context) error {
+	if r.isLoaded() {
+		return nil
+	}
+
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+
+	if r.isLoaded() {
+		return nil
+	}
+
+	return r.load(ctx)
+}
+
+func (r *MetadataRegistry) isLoaded() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.loaded
+}
+
+func registryKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,99 @@
+package wakago
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataRegistry(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/program_languages",
+		httpmock.NewStringResponder(200, `{"data": [{"id": "go", "name": "Go", "color": "#00ADD8"}, {"id": "brainfuck", "name": "Brainfuck", "color": null}]}`))
+	httpmock.RegisterResponderWithQuery("GET", "https://wakatime.com/api/v1/editors", "unreleased=true",
+		httpmock.NewStringResponder(200, `{"data": [{"id": "vscode", "name": "VS Code", "color": "#0078d7"}]}`))
+
+	client := NewClient(nil)
+	registry := NewMetadataRegistry(client)
+	ctx := context.Background()
+
+	color, err := registry.LanguageColor(ctx, "go")
+	assert.Nil(t, err)
+	assert.Equal(t, "#00ADD8", color)
+
+	color, err = registry.LanguageColor(ctx, "Brainfuck")
+	assert.Nil(t, err)
+	assert.Equal(t, "", color)
+
+	color, err = registry.EditorColor(ctx, "VS Code")
+	assert.Nil(t, err)
+	assert.Equal(t, "#0078d7", color)
+
+	editor, err := registry.Editor(ctx, "vscode")
+	assert.Nil(t, err)
+	assert.Equal(t, "VS Code", editor.Name)
+
+	language, err := registry.Language(ctx, "Unknown")
+	assert.Nil(t, err)
+	assert.Nil(t, language)
+
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	assert.Nil(t, registry.Load(ctx))
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func TestMetadataRegistry_loadError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/program_languages",
+		httpmock.NewStringResponder(500, `{"error": "Server Error"}`))
+
+	client := NewClient(nil)
+	registry := NewMetadataRegistry(client)
+
+	_, err := registry.LanguageColor(context.Background(), "Go")
+	assert.NotNil(t, err)
+
+	_, err = registry.LanguageColor(context.Background(), "Go")
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestMetadataRegistry_concurrentLoad(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/program_languages", func(request *http.Request) (*http.Response, error) {
+		time.Sleep(10 * time.Millisecond)
+		return httpmock.NewStringResponse(200, `{"data": [{"id": "go", "name": "Go", "color": "#00ADD8"}]}`), nil
+	})
+	httpmock.RegisterResponderWithQuery("GET", "https://wakatime.com/api/v1/editors", "unreleased=true",
+		httpmock.NewStringResponder(200, `{"data": []}`))
+
+	client := NewClient(nil)
+	registry := NewMetadataRegistry(client)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			color, err := registry.LanguageColor(context.Background(), "Go")
+			assert.Nil(t, err)
+			assert.Equal(t, "#00ADD8", color)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,75 @@
+package oauth
+
+import (
+	"errors"
+	"net/http"
+)
+
+// CallbackHandler handles the redirect back from the authorize URL and exchanges its code for a token.
+type CallbackHandler struct {
+	Config *Config
+
+	// Verify checks the state sent back by WakaTime against the user's session
+	// and returns the PKCE verifier passed to AuthCodeURL, or an empty string without PKCE.
+	Verify func(r *http.Request, state string) (verifier string, err error)
+
+	// OnToken is called with the token granted to the user and writes the response.
+	OnToken func(w http.ResponseWriter, r *http.Request, token *Token)
+
+	// OnError is called when the user denied access or the code could not be exchanged.
+	// It defaults to responding with 400 Bad Request.
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	if code := q.Get("error"); code != "" {
+		h.fail(w, r, &Error{StatusCode: http.StatusBadRequest, Code: code, Description: q.Get("error_description")})
+		return
+	}
+
+	if h.Config == nil {
+		h.fail(w, r, errors.New("callback has no Config"))
+		return
+	}
+
+	if h.Verify == nil {
+		h.fail(w, r, errors.New("callback has no Verify func"))
+		return
+	}
+
+	if h.OnToken == nil {
+		h.fail(w, r, errors.New("callback has no OnToken func"))
+		return
+	}
+
+	verifier, err := h.Verify(r, q.Get("state"))
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	code := q.Get("code")
+	if code == "" {
+		h.fail(w, r, errors.New("callback has no code"))
+		return
+	}
+
+	token, err := h.Config.Exchange(r.Context(), code, verifier)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+
+	h.OnToken(w, r, token)
+}
+
+func (h *CallbackHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	if h.OnError != nil {
+		h.OnError(w, r, err)
+		return
+	}
+
+	http.Error(w, "authorization failed", http.StatusBadRequest)
+}
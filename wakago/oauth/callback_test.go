@@ -0,0 +1,107 @@
+package oauth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCallbackHandler(config *Config) (*CallbackHandler, *[]error) {
+	var errs []error
+	handler := &CallbackHandler{
+		Config: config,
+		Verify: func(r *http.Request, state string) (string, error) {
+			if state != "state" {
+				return "", errors.New("state mismatch")
+			}
+
+			return "verifier", nil
+		},
+		OnToken: func(w http.ResponseWriter, r *http.Request, token *Token) {
+			w.Write([]byte(token.AccessToken))
+		},
+		OnError: func(w http.ResponseWriter, r *http.Request, err error) {
+			errs = append(errs, err)
+			w.WriteHeader(http.StatusForbidden)
+		},
+	}
+
+	return handler, &errs
+}
+
+func TestCallbackHandler(t *testing.T) {
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		assert.Equal(t, "auth-code", form.Get("code"))
+		assert.Equal(t, "verifier", form.Get("code_verifier"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "waka_tok_xxx"}`))
+	})
+	handler, errs := newCallbackHandler(config)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/callback?code=auth-code&state=state", nil))
+
+	assert.Empty(t, *errs)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "waka_tok_xxx", recorder.Body.String())
+}
+
+func TestCallbackHandler_denied(t *testing.T) {
+	handler, errs := newCallbackHandler(&Config{})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/callback?error=access_denied&state=state", nil))
+
+	assert.Equal(t, 403, recorder.Code)
+	assert.Equal(t, []error{&Error{StatusCode: 400, Code: "access_denied"}}, *errs)
+}
+
+func TestCallbackHandler_stateMismatch(t *testing.T) {
+	handler, errs := newCallbackHandler(&Config{})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/callback?code=auth-code&state=forged", nil))
+
+	assert.Equal(t, 403, recorder.Code)
+	assert.Equal(t, []error{errors.New("state mismatch")}, *errs)
+}
+
+func TestCallbackHandler_defaultOnError(t *testing.T) {
+	handler := &CallbackHandler{Config: &Config{}}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/callback?code=auth-code", nil))
+
+	assert.Equal(t, 400, recorder.Code)
+}
+
+func TestCallbackHandler_noConfig(t *testing.T) {
+	handler, errs := newCallbackHandler(nil)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/callback?code=auth-code&state=state", nil))
+
+	assert.Equal(t, 403, recorder.Code)
+	assert.Equal(t, []error{errors.New("callback has no Config")}, *errs)
+}
+
+func TestCallbackHandler_noOnToken(t *testing.T) {
+	exchanged := false
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		exchanged = true
+	})
+	handler, errs := newCallbackHandler(config)
+	handler.OnToken = nil
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/callback?code=auth-code&state=state", nil))
+
+	assert.False(t, exchanged)
+	assert.Equal(t, 403, recorder.Code)
+	assert.Equal(t, []error{errors.New("callback has no OnToken func")}, *errs)
+}
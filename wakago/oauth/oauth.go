@@ -0,0 +1,354 @@
+// Package oauth implements the WakaTime OAuth 2.0 authorization code flow, with PKCE, token refresh and revoke.
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Scope string
+
+const (
+	ScopeEmail                    Scope = "email"
+	ScopeReadStats                Scope = "read_stats"
+	ScopeReadSummaries            Scope = "read_summaries"
+	ScopeReadHeartbeats           Scope = "read_heartbeats"
+	ScopeWriteHeartbeats          Scope = "write_heartbeats"
+	ScopeReadGoals                Scope = "read_goals"
+	ScopeReadOrgs                 Scope = "read_orgs"
+	ScopeWriteOrgs                Scope = "write_orgs"
+	ScopeReadPrivateLeaderboards  Scope = "read_private_leaderboards"
+	ScopeWritePrivateLeaderboards Scope = "write_private_leaderboards"
+)
+
+// Endpoint holds the OAuth URLs of a WakaTime compatible server.
+type Endpoint struct {
+	AuthURL   string
+	TokenURL  string
+	RevokeURL string
+
+	// APIURL is the base URL of the API. Transport only sends the token to URLs under it.
+	APIURL string
+}
+
+var WakaTimeEndpoint = Endpoint{
+	AuthURL:   "https://wakatime.com/oauth/authorize",
+	TokenURL:  "https://wakatime.com/oauth/token",
+	RevokeURL: "https://wakatime.com/oauth/revoke",
+	APIURL:    "https://wakatime.com/api/v1/",
+}
+
+// Config is the OAuth app registered at https://wakatime.com/apps.
+// Endpoint defaults to WakaTimeEndpoint and HTTPClient to http.DefaultClient.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []Scope
+	Endpoint     Endpoint
+	HTTPClient   *http.Client
+}
+
+// Token is the access token granted to the app, with the refresh token to renew it before ExpiresAt.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	TokenType    string    `json:"token_type"`
+	Scope        string    `json:"scope"`
+	UID          string    `json:"uid"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// How long before ExpiresAt a token is considered expired, so it is not sent right as it expires.
+const expiryDelta = 30 * time.Second
+
+// Valid reports whether the token has an access token which is not about to expire.
+// A token without ExpiresAt never expires.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+
+	return t.ExpiresAt.IsZero() || time.Now().Add(expiryDelta).Before(t.ExpiresAt)
+}
+
+// Error is an error returned by the token or revoke endpoint.
+type Error struct {
+	StatusCode  int
+	Code        string
+	Description string
+}
+
+func (e *Error) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth error %v : %v : %v", e.StatusCode, e.Code, e.Description)
+	}
+
+	return fmt.Sprintf("oauth error %v : %v", e.StatusCode, e.Code)
+}
+
+// AuthCodeURL returns the URL to send the user to for authorizing the app.
+// state is returned to the callback unchanged, and verifier enables PKCE unless it is empty.
+func (c *Config) AuthCodeURL(state, verifier string) string {
+	v := url.Values{
+		"client_id":     {c.ClientID},
+		"response_type": {"code"},
+	}
+
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+
+	if len(c.Scopes) > 0 {
+		scopes := make([]string, len(c.Scopes))
+		for i, scope := range c.Scopes {
+			scopes[i] = string(scope)
+		}
+
+		v.Set("scope", strings.Join(scopes, ","))
+	}
+
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	if verifier != "" {
+		v.Set("code_challenge", S256Challenge(verifier))
+		v.Set("code_challenge_method", "S256")
+	}
+
+	authURL := c.endpoint().AuthURL
+	if strings.Contains(authURL, "?") {
+		return authURL + "&" + v.Encode()
+	}
+
+	return authURL + "?" + v.Encode()
+}
+
+// Exchange exchanges the authorization code received by the callback for a token.
+// verifier must be the one passed to AuthCodeURL.
+func (c *Config) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	v := url.Values{
+		"grant_type": {"authorization_code"},
+		"code":       {code},
+	}
+
+	if verifier != "" {
+		v.Set("code_verifier", verifier)
+	}
+
+	return c.retrieveToken(ctx, v)
+}
+
+// Refresh renews a token with its refresh token.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refresh token is empty")
+	}
+
+	return c.retrieveToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+// Revoke invalidates the access or refresh token.
+func (c *Config) Revoke(ctx context.Context, token string) error {
+	response, err := c.postForm(ctx, c.endpoint().RevokeURL, url.Values{"token": {token}})
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		body, _ := io.ReadAll(response.Body)
+		return parseError(response, body)
+	}
+
+	return nil
+}
+
+func (c *Config) retrieveToken(ctx context.Context, v url.Values) (*Token, error) {
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+
+	response, err := c.postForm(ctx, c.endpoint().TokenURL, v)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= 300 {
+		return nil, parseError(response, body)
+	}
+
+	token, err := parseToken(response, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, errors.New("token response has no access_token")
+	}
+
+	return token, nil
+}
+
+func (c *Config) postForm(ctx context.Context, endpointURL string, v url.Values) (*http.Response, error) {
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+
+	request, err := http.NewRequestWithContext(ctx, "POST", endpointURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	return c.httpClient().Do(request)
+}
+
+func (c *Config) endpoint() Endpoint {
+	e := c.Endpoint
+	if e.AuthURL == "" {
+		e.AuthURL = WakaTimeEndpoint.AuthURL
+	}
+
+	if e.TokenURL == "" {
+		e.TokenURL = WakaTimeEndpoint.TokenURL
+	}
+
+	if e.RevokeURL == "" {
+		e.RevokeURL = WakaTimeEndpoint.RevokeURL
+	}
+
+	if e.APIURL == "" {
+		e.APIURL = WakaTimeEndpoint.APIURL
+	}
+
+	return e
+}
+
+// isAPIURL reports whether u is under the API URL of the endpoint, so it may be sent the token.
+func (c *Config) isAPIURL(u *url.URL) bool {
+	api, err := url.Parse(c.endpoint().APIURL)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(u.Scheme, api.Scheme) &&
+		strings.EqualFold(u.Host, api.Host) &&
+		strings.HasPrefix(u.Path, api.Path)
+}
+
+func (c *Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+
+	return http.DefaultClient
+}
+
+// tokenJSON is a token response as sent by the token endpoint, in JSON or form encoding.
+type tokenJSON struct {
+	AccessToken  string      `json:"access_token"`
+	RefreshToken string      `json:"refresh_token"`
+	TokenType    string      `json:"token_type"`
+	Scope        string      `json:"scope"`
+	UID          string      `json:"uid"`
+	ExpiresIn    json.Number `json:"expires_in"`
+	ExpiresAt    string      `json:"expires_at"`
+}
+
+func parseToken(response *http.Response, body []byte) (*Token, error) {
+	var t tokenJSON
+	if isJSON(response) {
+		if err := json.Unmarshal(body, &t); err != nil {
+			return nil, err
+		}
+	} else {
+		v, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, err
+		}
+
+		t = tokenJSON{
+			AccessToken:  v.Get("access_token"),
+			RefreshToken: v.Get("refresh_token"),
+			TokenType:    v.Get("token_type"),
+			Scope:        v.Get("scope"),
+			UID:          v.Get("uid"),
+			ExpiresIn:    json.Number(v.Get("expires_in")),
+			ExpiresAt:    v.Get("expires_at"),
+		}
+	}
+
+	token := &Token{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		TokenType:    t.TokenType,
+		Scope:        t.Scope,
+		UID:          t.UID,
+	}
+
+	if t.ExpiresIn != "" {
+		seconds, err := strconv.ParseFloat(string(t.ExpiresIn), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expires_in : %w", err)
+		}
+
+		token.ExpiresAt = time.Now().Add(time.Duration(seconds * float64(time.Second)))
+	} else if t.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, t.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expires_at : %w", err)
+		}
+
+		token.ExpiresAt = expiresAt
+	}
+
+	return token, nil
+}
+
+func parseError(response *http.Response, body []byte) error {
+	e := &Error{StatusCode: response.StatusCode}
+
+	var v struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	if isJSON(response) {
+		json.Unmarshal(body, &v)
+	} else if q, err := url.ParseQuery(string(body)); err == nil {
+		v.Error, v.ErrorDescription = q.Get("error"), q.Get("error_description")
+	}
+
+	e.Code, e.Description = v.Error, v.ErrorDescription
+	if e.Code == "" {
+		e.Code = http.StatusText(response.StatusCode)
+	}
+
+	return e
+}
+
+func isJSON(response *http.Response) bool {
+	mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	return mediaType == "application/json"
+}
@@ -0,0 +1,172 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTokenServer starts a stub token server calling handler with the parsed form of each request.
+func newTokenServer(t *testing.T, handler func(w http.ResponseWriter, form url.Values)) (*httptest.Server, *Config) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+
+		handler(w, r.PostForm)
+	}))
+	t.Cleanup(server.Close)
+
+	config := &Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://example.com/callback",
+		Endpoint: Endpoint{
+			AuthURL:   server.URL + "/oauth/authorize",
+			TokenURL:  server.URL + "/oauth/token",
+			RevokeURL: server.URL + "/oauth/revoke",
+		},
+	}
+
+	return server, config
+}
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	config := &Config{
+		ClientID:    "client-id",
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []Scope{ScopeReadStats, ScopeReadSummaries},
+	}
+
+	authURL, err := url.Parse(config.AuthCodeURL("state", "verifier"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "https://wakatime.com/oauth/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	assert.Equal(t, url.Values{
+		"client_id":             {"client-id"},
+		"response_type":         {"code"},
+		"redirect_uri":          {"https://example.com/callback"},
+		"scope":                 {"read_stats,read_summaries"},
+		"state":                 {"state"},
+		"code_challenge":        {S256Challenge("verifier")},
+		"code_challenge_method": {"S256"},
+	}, authURL.Query())
+}
+
+func TestConfig_AuthCodeURL_withoutPKCE(t *testing.T) {
+	config := &Config{ClientID: "client-id"}
+
+	assert.Equal(t, "https://wakatime.com/oauth/authorize?client_id=client-id&response_type=code", config.AuthCodeURL("", ""))
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		assert.Equal(t, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"auth-code"},
+			"code_verifier": {"verifier"},
+			"redirect_uri":  {"https://example.com/callback"},
+			"client_id":     {"client-id"},
+			"client_secret": {"client-secret"},
+		}, form)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "waka_tok_xxx", "refresh_token": "waka_ref_xxx", "token_type": "bearer", "scope": "read_stats", "uid": "user-id", "expires_in": 3600}`))
+	})
+
+	token, err := config.Exchange(context.Background(), "auth-code", "verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "waka_tok_xxx", token.AccessToken)
+	assert.Equal(t, "waka_ref_xxx", token.RefreshToken)
+	assert.Equal(t, "bearer", token.TokenType)
+	assert.Equal(t, "read_stats", token.Scope)
+	assert.Equal(t, "user-id", token.UID)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+	assert.True(t, token.Valid())
+}
+
+func TestConfig_Exchange_formEncoded(t *testing.T) {
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		w.Write([]byte("access_token=waka_tok_xxx&refresh_token=waka_ref_xxx&token_type=bearer&uid=user-id&expires_at=2030-01-02T03%3A04%3A05Z"))
+	})
+
+	token, err := config.Exchange(context.Background(), "auth-code", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &Token{
+		AccessToken:  "waka_tok_xxx",
+		RefreshToken: "waka_ref_xxx",
+		TokenType:    "bearer",
+		UID:          "user-id",
+		ExpiresAt:    time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, token)
+}
+
+func TestConfig_Exchange_error(t *testing.T) {
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": "invalid_grant", "error_description": "code expired"}`))
+	})
+
+	_, err := config.Exchange(context.Background(), "auth-code", "")
+
+	assert.Equal(t, &Error{StatusCode: 400, Code: "invalid_grant", Description: "code expired"}, err)
+	assert.EqualError(t, err, "oauth error 400 : invalid_grant : code expired")
+}
+
+func TestConfig_Refresh(t *testing.T) {
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		assert.Equal(t, "refresh_token", form.Get("grant_type"))
+		assert.Equal(t, "waka_ref_xxx", form.Get("refresh_token"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "waka_tok_new", "refresh_token": "waka_ref_new", "expires_in": 3600}`))
+	})
+
+	token, err := config.Refresh(context.Background(), "waka_ref_xxx")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "waka_tok_new", token.AccessToken)
+	assert.Equal(t, "waka_ref_new", token.RefreshToken)
+
+	_, err = config.Refresh(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestConfig_Revoke(t *testing.T) {
+	var revoked string
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		revoked = form.Get("token")
+		assert.Equal(t, "client-secret", form.Get("client_secret"))
+	})
+
+	err := config.Revoke(context.Background(), "waka_tok_xxx")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "waka_tok_xxx", revoked)
+}
+
+func TestToken_Valid(t *testing.T) {
+	var token *Token
+	assert.False(t, token.Valid())
+	assert.False(t, (&Token{}).Valid())
+	assert.True(t, (&Token{AccessToken: "waka_tok_xxx"}).Valid())
+	assert.True(t, (&Token{AccessToken: "waka_tok_xxx", ExpiresAt: time.Now().Add(time.Hour)}).Valid())
+	assert.False(t, (&Token{AccessToken: "waka_tok_xxx", ExpiresAt: time.Now().Add(time.Second)}).Valid())
+}
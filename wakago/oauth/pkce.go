@@ -0,0 +1,33 @@
+package oauth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// GenerateVerifier returns a random PKCE code verifier.
+// Keep it with the user's session and pass it to both AuthCodeURL and Exchange.
+func GenerateVerifier() (string, error) {
+	return randomString(32)
+}
+
+// GenerateState returns a random state to protect the callback against CSRF.
+func GenerateState() (string, error) {
+	return randomString(16)
+}
+
+// S256Challenge returns the S256 PKCE code challenge of verifier.
+func S256Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,37 @@
+package oauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestS256Challenge(t *testing.T) {
+	// BASE64URL(SHA256("verifier")) without padding.
+	assert.Equal(t, "iMnq5o6zALKXGivsnlom_0F5_WYda32GHkxlV7mq7hQ", S256Challenge("verifier"))
+}
+
+func TestGenerateVerifier(t *testing.T) {
+	v1, err := GenerateVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v2, _ := GenerateVerifier()
+
+	assert.Len(t, v1, 43)
+	assert.Regexp(t, `^[A-Za-z0-9_-]+$`, v1)
+	assert.NotEqual(t, v1, v2)
+}
+
+func TestGenerateState(t *testing.T) {
+	s1, err := GenerateState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s2, _ := GenerateState()
+
+	assert.NotEmpty(t, s1)
+	assert.NotEqual(t, s1, s2)
+}
@@ -0,0 +1,108 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// TokenSource holds a token and refreshes it when it is about to expire. It is safe for concurrent use.
+//
+// It is a wakago.Authenticator, so it can be passed to wakago.WithAuthenticator,
+// or used through Transport with wakago.WithHTTPClient. With a server other than WakaTime,
+// Endpoint.APIURL must then be the client's base URL.
+type TokenSource struct {
+	config *Config
+
+	mu    sync.Mutex
+	token *Token
+
+	// OnRefresh is called with every refreshed token, so it can be stored for the user.
+	OnRefresh func(token *Token)
+}
+
+// TokenSource returns a TokenSource starting from token.
+func (c *Config) TokenSource(token *Token) *TokenSource {
+	return &TokenSource{config: c, token: token}
+}
+
+// Client returns an http.Client sending API requests with a token from token, refreshed when it expires.
+func (c *Config) Client(token *Token) *http.Client {
+	return &http.Client{Transport: &Transport{Source: c.TokenSource(token)}}
+}
+
+// Token returns a valid token, refreshing the current one first when it is about to expire.
+// A refreshed token without a refresh token keeps the previous one.
+func (s *TokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, errors.New("token expired and has no refresh token")
+	}
+
+	token, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+
+	s.token = token
+	if s.OnRefresh != nil {
+		s.OnRefresh(token)
+	}
+
+	return token, nil
+}
+
+func (s *TokenSource) Authenticate(request *http.Request) error {
+	token, err := s.Token(request.Context())
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return nil
+}
+
+// Transport authenticates requests with a token from Source before sending them with Base,
+// or http.DefaultTransport when Base is nil. Only requests under the Endpoint.APIURL of the
+// Source's Config get the token, so it is not sent to other hosts such as data dump downloads.
+type Transport struct {
+	Source *TokenSource
+	Base   http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if !t.Source.config.isAPIURL(request.URL) {
+		return t.base().RoundTrip(request)
+	}
+
+	// A RoundTripper must not modify the request, so the token is set on a copy.
+	authenticated := request.Clone(request.Context())
+	if err := t.Source.Authenticate(authenticated); err != nil {
+		if request.Body != nil {
+			request.Body.Close()
+		}
+
+		return nil, err
+	}
+
+	return t.base().RoundTrip(authenticated)
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+
+	return http.DefaultTransport
+}
@@ -0,0 +1,132 @@
+package oauth
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+
+	"yamash723/wakago/wakago"
+)
+
+var _ wakago.Authenticator = (*TokenSource)(nil)
+
+func TestTokenSource_Token(t *testing.T) {
+	refreshes := 0
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		refreshes++
+		assert.Equal(t, "waka_ref_old", form.Get("refresh_token"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "waka_tok_new", "expires_in": 3600}`))
+	})
+
+	var refreshed *Token
+	source := config.TokenSource(&Token{AccessToken: "waka_tok_old", RefreshToken: "waka_ref_old", ExpiresAt: time.Now().Add(-time.Minute)})
+	source.OnRefresh = func(token *Token) { refreshed = token }
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			token, err := source.Token(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, "waka_tok_new", token.AccessToken)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, refreshes)
+	if assert.NotNil(t, refreshed) {
+		assert.Equal(t, "waka_tok_new", refreshed.AccessToken)
+		assert.Equal(t, "waka_ref_old", refreshed.RefreshToken)
+	}
+}
+
+func TestTokenSource_Token_noRefreshToken(t *testing.T) {
+	config := &Config{}
+	source := config.TokenSource(&Token{AccessToken: "waka_tok_old", ExpiresAt: time.Now().Add(-time.Minute)})
+
+	_, err := source.Token(context.Background())
+	assert.Error(t, err)
+}
+
+func TestConfig_Client(t *testing.T) {
+	_, config := newTokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "waka_tok_new", "refresh_token": "waka_ref_new", "expires_in": 3600}`))
+	})
+
+	var authorization string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Write([]byte(`{"data": {"id": "user-id"}}`))
+	}))
+	defer api.Close()
+
+	config.Endpoint.APIURL = api.URL
+	token := &Token{AccessToken: "waka_tok_old", RefreshToken: "waka_ref_old", ExpiresAt: time.Now().Add(-time.Minute)}
+	client, err := wakago.New(wakago.WithHTTPClient(config.Client(token)), wakago.WithBaseURL(api.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := client.UsersService.Get(context.Background(), "current")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "user-id", user.Data.Id)
+	assert.Equal(t, "Bearer waka_tok_new", authorization)
+}
+
+func TestConfig_Client_download(t *testing.T) {
+	var authorization []string
+	download := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Values("Authorization")
+		w.Write([]byte(`{"user": {}, "range": {}, "days": []}`))
+	}))
+	defer download.Close()
+
+	config := &Config{}
+	client, err := wakago.New(wakago.WithHTTPClient(config.Client(&Token{AccessToken: "waka_tok_xxx"})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dump := &wakago.DataDumpsData{Status: "Completed", DownloadUrl: null.StringFrom(download.URL + "/dumps/dump.json")}
+	err = client.DataDumpsService.Download(context.Background(), dump, io.Discard)
+
+	assert.Nil(t, err)
+	assert.Empty(t, authorization)
+}
+
+func TestTokenSource_Authenticate(t *testing.T) {
+	var authorization string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Write([]byte(`{"data": {"id": "user-id"}}`))
+	}))
+	defer api.Close()
+
+	config := &Config{}
+	source := config.TokenSource(&Token{AccessToken: "waka_tok_xxx"})
+	client, err := wakago.New(wakago.WithAuthenticator(source), wakago.WithBaseURL(api.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.UsersService.Get(context.Background(), "current")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer waka_tok_xxx", authorization)
+}
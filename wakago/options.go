@@ -0,0 +1,174 @@
+package wakago
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Option configures a Client created by New.
+type Option func(*Client) error
+
+// Logger receives a line for every request the client sends: its method, URL, status code and duration.
+// Headers are never logged, so credentials do not end up in the log.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// RateLimiter is waited on before every request the client sends, including retries.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// RetryAttempt describes a finished attempt to send a request.
+// Response is nil when the request failed before a response was received, in which case Err is set.
+type RetryAttempt struct {
+	Attempt  int
+	Elapsed  time.Duration
+	Request  *http.Request
+	Response *http.Response
+	Err      error
+}
+
+// RetryPolicy decides whether a request is sent again after an attempt, and how long to wait before it.
+// Requests whose body cannot be rewound are never retried,
+// and RateLimiter and Authenticator errors are returned without consulting the policy.
+type RetryPolicy interface {
+	Retry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// WithHTTPClient sends requests with httpClient, so its transport, timeout and proxy settings apply.
+// A nil httpClient keeps the default client.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient != nil {
+			c.client = httpClient
+		}
+
+		return nil
+	}
+}
+
+// WithBaseURL sends requests to a WakaTime compatible server serving its API at baseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		return c.SetBaseURL(baseURL)
+	}
+}
+
+// WithWakapi sends requests to the Wakapi server at serverURL and enables CompatibilityMode.
+func WithWakapi(serverURL string) Option {
+	return func(c *Client) error {
+		if err := c.SetBaseURL(WakapiBaseURL(serverURL)); err != nil {
+			return err
+		}
+
+		c.CompatibilityMode = true
+		return nil
+	}
+}
+
+// WithCompatibilityMode enables or disables CompatibilityMode.
+func WithCompatibilityMode(enabled bool) Option {
+	return func(c *Client) error {
+		c.CompatibilityMode = enabled
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithAuthenticator adds the credentials of authenticator to every request the client sends to its base URL.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(c *Client) error {
+		c.authenticator = authenticator
+		return nil
+	}
+}
+
+// WithAPIKey authenticates every request with the secret API key using HTTP Basic auth.
+func WithAPIKey(apiKey string) Option {
+	return func(c *Client) error {
+		if apiKey == "" {
+			return errors.New("api key is empty")
+		}
+
+		c.authenticator = APIKeyAuth{APIKey: apiKey}
+		return nil
+	}
+}
+
+// WithAccessToken authenticates every request with the OAuth access token.
+func WithAccessToken(token string) Option {
+	return func(c *Client) error {
+		if token == "" {
+			return errors.New("access token is empty")
+		}
+
+		c.authenticator = BearerTokenAuth{Token: token}
+		return nil
+	}
+}
+
+// WithLogger logs every request the client sends to logger.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithRateLimiter waits on limiter before every request the client sends.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Client) error {
+		c.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithRetryPolicy sends failed requests again as policy decides.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// rewindRequest returns a copy of request with a fresh body, so it can be sent again.
+func rewindRequest(request *http.Request) (*http.Request, error) {
+	next := request.Clone(request.Context())
+	if request.Body == nil || request.Body == http.NoBody {
+		return next, nil
+	}
+
+	if request.GetBody == nil {
+		return nil, errors.New("request body cannot be rewound")
+	}
+
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	next.Body = body
+	return next, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
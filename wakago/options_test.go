@@ -0,0 +1,236 @@
+package wakago
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+type testRateLimiter struct {
+	calls int
+	err   error
+}
+
+func (l *testRateLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return l.err
+}
+
+type testRetryPolicy struct {
+	attempts []RetryAttempt
+	max      int
+}
+
+func (p *testRetryPolicy) Retry(attempt RetryAttempt) (time.Duration, bool) {
+	p.attempts = append(p.attempts, attempt)
+	if attempt.Attempt >= p.max {
+		return 0, false
+	}
+
+	return time.Millisecond, attempt.Err != nil || attempt.Response.StatusCode >= 500
+}
+
+func TestNew(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	logger, limiter, policy := &testLogger{}, &testRateLimiter{}, &testRetryPolicy{}
+
+	c, err := New(
+		WithHTTPClient(httpClient),
+		WithBaseURL("https://wakapi.example.com/api"),
+		WithUserAgent("my-app/1.0"),
+		WithAPIKey("secret"),
+		WithLogger(logger),
+		WithRateLimiter(limiter),
+		WithRetryPolicy(policy),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Same(t, httpClient, c.client)
+	assert.Equal(t, "https://wakapi.example.com/api/", c.BaseURL().String())
+	assert.Equal(t, "my-app/1.0", c.UserAgent)
+	assert.Equal(t, APIKeyAuth{APIKey: "secret"}, c.authenticator)
+	assert.Same(t, logger, c.logger)
+	assert.Same(t, limiter, c.rateLimiter)
+	assert.Same(t, policy, c.retryPolicy)
+	assert.False(t, c.CompatibilityMode)
+}
+
+func TestNew_invalidOption(t *testing.T) {
+	_, err := New(WithBaseURL("/relative"))
+	assert.Error(t, err)
+
+	_, err = New(WithAPIKey(""))
+	assert.Error(t, err)
+
+	_, err = New(WithAccessToken(""))
+	assert.Error(t, err)
+}
+
+func TestNew_wakapi(t *testing.T) {
+	c, err := New(WithWakapi("https://wakapi.dev"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "https://wakapi.dev/api/compat/wakatime/v1/", c.BaseURL().String())
+	assert.True(t, c.CompatibilityMode)
+
+	c, err = New(WithWakapi("https://wakapi.dev"), WithCompatibilityMode(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, c.CompatibilityMode)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(200, `{}`))
+
+	c := NewClient(&http.Client{Transport: transport})
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(context.Background(), request, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, transport.GetTotalCallCount())
+}
+
+func TestNewRequest_defaultHeaderNotShared(t *testing.T) {
+	c := NewClient(nil)
+	c.DefaultHeader = &http.Header{"X-Default": []string{"1"}}
+
+	request, _ := c.NewRequest("POST", "foo", struct{}{})
+	request.Header.Set("X-Test", "1")
+
+	assert.Equal(t, "1", request.Header.Get("X-Default"))
+	assert.Empty(t, c.DefaultHeader.Get("Content-Type"))
+	assert.Empty(t, c.DefaultHeader.Get("X-Test"))
+}
+
+func TestWithLogger(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(404, `{}`))
+
+	logger := &testLogger{}
+	c, _ := New(WithAPIKey("secret"), WithLogger(logger))
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(context.Background(), request, nil)
+	assert.Error(t, err)
+
+	if assert.Len(t, logger.lines, 1) {
+		assert.Contains(t, logger.lines[0], "GET https://wakatime.com/api/v1/foo 404")
+		assert.NotContains(t, logger.lines[0], "c2VjcmV0")
+	}
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(200, `{}`))
+
+	limiter := &testRateLimiter{}
+	c, _ := New(WithRateLimiter(limiter))
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(context.Background(), request, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, limiter.calls)
+
+	limiter.err = errors.New("limited")
+	_, err = c.Do(context.Background(), request, nil)
+	assert.EqualError(t, err, "limited")
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	var bodies []string
+	httpmock.RegisterResponder("POST", "https://wakatime.com/api/v1/foo", func(request *http.Request) (*http.Response, error) {
+		calls++
+		body, _ := io.ReadAll(request.Body)
+		bodies = append(bodies, string(body))
+
+		if calls < 3 {
+			return httpmock.NewStringResponse(503, `{}`), nil
+		}
+
+		return httpmock.NewStringResponse(201, `{"message":"ok"}`), nil
+	})
+
+	policy := &testRetryPolicy{max: 5}
+	c, _ := New(WithRetryPolicy(policy))
+	request, _ := c.NewRequest("POST", "foo", map[string]string{"entity": "main.go"})
+
+	v := struct {
+		Message string `json:"message"`
+	}{}
+	response, err := c.Do(context.Background(), request, &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 201, response.StatusCode)
+	assert.Equal(t, "ok", v.Message)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []string{`{"entity":"main.go"}` + "\n", `{"entity":"main.go"}` + "\n", `{"entity":"main.go"}` + "\n"}, bodies)
+	assert.Len(t, policy.attempts, 3)
+	assert.Equal(t, 503, policy.attempts[0].Response.StatusCode)
+}
+
+func TestWithRetryPolicy_givesUp(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(500, `{}`))
+
+	c, _ := New(WithRetryPolicy(&testRetryPolicy{max: 2}))
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	response, err := c.Do(context.Background(), request, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 500, response.StatusCode)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestWithRetryPolicy_contextDone(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(500, `{}`))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c, _ := New(WithRetryPolicy(&testRetryPolicy{max: 5}))
+	request, _ := c.NewRequest("GET", "foo", nil)
+
+	_, err := c.Do(ctx, request, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
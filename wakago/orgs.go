@@ -0,0 +1,295 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"gopkg.in/guregu/null.v4"
+)
+
+type OrgsService service
+
+type Orgs struct {
+	Data       []OrgsData `json:"data"`
+	Total      int        `json:"total"`
+	TotalPages int        `json:"total_pages"`
+}
+
+type OrgsData struct {
+	Id                    string    `json:"id"`
+	Name                  string    `json:"name"`
+	Timezone              string    `json:"timezone"`
+	Timeout               int       `json:"timeout"`
+	WritesOnly            bool      `json:"writes_only"`
+	DefaultProjectPrivacy string    `json:"default_project_privacy"`
+	CreatedAt             time.Time `json:"created_at"`
+}
+
+type OrgDashboards struct {
+	Data       []OrgDashboardsData `json:"data"`
+	Total      int                 `json:"total"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+type OrgDashboardsData struct {
+	Id                     string    `json:"id"`
+	FullName               string    `json:"full_name"`
+	Timezone               string    `json:"timezone"`
+	MembersCount           int       `json:"members_count"`
+	MembersThatCanSeeStats int       `json:"members_that_can_see_stats"`
+	IsCurrentUserAdmin     bool      `json:"is_current_user_admin"`
+	IsCurrentUserMember    bool      `json:"is_current_user_member"`
+	CreatedAt              time.Time `json:"created_at"`
+	ModifiedAt             null.Time `json:"modified_at"`
+}
+
+type OrgDashboardMembers struct {
+	Data       []OrgDashboardMembersData `json:"data"`
+	Total      int                       `json:"total"`
+	TotalPages int                       `json:"total_pages"`
+}
+
+type OrgDashboardMembersData struct {
+	Id         string      `json:"id"`
+	Email      null.String `json:"email"`
+	FullName   null.String `json:"full_name"`
+	Username   null.String `json:"username"`
+	Photo      string      `json:"photo"`
+	IsViewOnly bool        `json:"is_view_only"`
+}
+
+type OrgDashboardMembersGetOptions struct {
+	Page *int `url:"page,omitempty"`
+}
+
+func (service *OrgsService) GetAll(ctx context.Context, userId string) (*Orgs, error) {
+	v, _, err := service.GetAllWithResponse(ctx, userId)
+	return v, err
+}
+
+// GetAllWithResponse is like GetAll, and also returns the API response.
+func (service *OrgsService) GetAllWithResponse(ctx context.Context, userId string) (*Orgs, *Response, error) {
+	path := fmt.Sprintf("users/%v/orgs", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(Orgs)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *OrgsService) GetDashboards(ctx context.Context, userId string, orgId string) (*OrgDashboards, error) {
+	v, _, err := service.GetDashboardsWithResponse(ctx, userId, orgId)
+	return v, err
+}
+
+// GetDashboardsWithResponse is like GetDashboards, and also returns the API response.
+func (service *OrgsService) GetDashboardsWithResponse(ctx context.Context, userId string, orgId string) (*OrgDashboards, *Response, error) {
+	path := fmt.Sprintf("users/%v/orgs/%v/dashboards", userId, orgId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(OrgDashboards)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *OrgsService) GetDashboardMembers(ctx context.Context, userId string, orgId string, dashboardId string, opts *OrgDashboardMembersGetOptions) (*OrgDashboardMembers, error) {
+	v, _, err := service.GetDashboardMembersWithResponse(ctx, userId, orgId, dashboardId, opts)
+	return v, err
+}
+
+// GetDashboardMembersWithResponse is like GetDashboardMembers, and also returns the API response.
+func (service *OrgsService) GetDashboardMembersWithResponse(ctx context.Context, userId string, orgId string, dashboardId string, opts *OrgDashboardMembersGetOptions) (*OrgDashboardMembers, *Response, error) {
+	path := fmt.Sprintf("users/%v/orgs/%v/dashboards/%v/members", userId, orgId, dashboardId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(OrgDashboardMembers)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// IterateDashboardMembers returns an iterator over every page of a dashboard's members.
+func (service *OrgsService) IterateDashboardMembers(userId string, orgId string, dashboardId string) *PageIterator[OrgDashboardMembers] {
+	return newPageIterator(1, func(ctx context.Context, page int) (*OrgDashboardMembers, *Response, int, error) {
+		v, response, err := service.GetDashboardMembersWithResponse(ctx, userId, orgId, dashboardId, &OrgDashboardMembersGetOptions{Page: &page})
+		if err != nil {
+			return nil, response, 0, err
+		}
+
+		return v, response, nextPage(page, v.TotalPages), nil
+	})
+}
+
+func (service *OrgsService) GetMemberSummaries(ctx context.Context, userId string, orgId string, dashboardId string, memberId string, opts *SummariesGetOptions) (*Summaries, error) {
+	v, _, err := service.GetMemberSummariesWithResponse(ctx, userId, orgId, dashboardId, memberId, opts)
+	return v, err
+}
+
+// GetMemberSummariesWithResponse is like GetMemberSummaries, and also returns the API response.
+func (service *OrgsService) GetMemberSummariesWithResponse(ctx context.Context, userId string, orgId string, dashboardId string, memberId string, opts *SummariesGetOptions) (*Summaries, *Response, error) {
+	path := fmt.Sprintf("users/%v/orgs/%v/dashboards/%v/members/%v/summaries", userId, orgId, dashboardId, memberId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Summaries)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *OrgsService) GetMemberDurations(ctx context.Context, userId string, orgId string, dashboardId string, memberId string, opts *DurationsGetOptions) (*Durations, error) {
+	v, _, err := service.GetMemberDurationsWithResponse(ctx, userId, orgId, dashboardId, memberId, opts)
+	return v, err
+}
+
+// GetMemberDurationsWithResponse is like GetMemberDurations, and also returns the API response.
+func (service *OrgsService) GetMemberDurationsWithResponse(ctx context.Context, userId string, orgId string, dashboardId string, memberId string, opts *DurationsGetOptions) (*Durations, *Response, error) {
+	path := fmt.Sprintf("users/%v/orgs/%v/dashboards/%v/members/%v/durations", userId, orgId, dashboardId, memberId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Durations)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// GetAllMemberSummaries fetches the summaries of every member of a dashboard, keyed by member id.
+// At most concurrency requests are in flight at once, and the first error cancels the remaining requests.
+func (service *OrgsService) GetAllMemberSummaries(ctx context.Context, userId string, orgId string, dashboardId string, opts *SummariesGetOptions, concurrency int) (map[string]*Summaries, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	members := []OrgDashboardMembersData{}
+	it := service.IterateDashboardMembers(userId, orgId, dashboardId)
+	for it.Next(ctx) {
+		members = append(members, it.Page().Data...)
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	results := make(map[string]*Summaries, len(members))
+	sem := make(chan struct{}, concurrency)
+
+	for _, member := range members {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(memberId string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			v, err := service.GetMemberSummaries(ctx, userId, orgId, dashboardId, memberId, opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+
+				return
+			}
+
+			results[memberId] = v
+		}(member.Id)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,272 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestOrgs_GetAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"created_at": "2022-10-13T01:22:05Z",
+				"default_project_privacy": "visible",
+				"id": "0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f",
+				"name": "wakago-org",
+				"timeout": 15,
+				"timezone": "Asia/Tokyo",
+				"writes_only": false
+			}
+		],
+		"total": 1,
+		"total_pages": 1
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/orgs"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.OrgsService.GetAll(context.Background(), "current")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Orgs{
+		Data: []OrgsData{
+			{
+				Id:                    "0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f",
+				Name:                  "wakago-org",
+				Timezone:              "Asia/Tokyo",
+				Timeout:               15,
+				DefaultProjectPrivacy: "visible",
+				CreatedAt:             time.Date(2022, 10, 13, 1, 22, 5, 0, time.UTC),
+			},
+		},
+		Total:      1,
+		TotalPages: 1,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestOrgs_GetDashboards(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"created_at": "2022-10-13T01:22:05Z",
+				"full_name": "Backend",
+				"id": "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a",
+				"is_current_user_admin": true,
+				"is_current_user_member": true,
+				"members_count": 2,
+				"members_that_can_see_stats": 1,
+				"modified_at": null,
+				"timezone": "Asia/Tokyo"
+			}
+		],
+		"total": 1,
+		"total_pages": 1
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/orgs/wakago-org/dashboards"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.OrgsService.GetDashboards(context.Background(), "current", "wakago-org")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := OrgDashboards{
+		Data: []OrgDashboardsData{
+			{
+				Id:                     "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a",
+				FullName:               "Backend",
+				Timezone:               "Asia/Tokyo",
+				MembersCount:           2,
+				MembersThatCanSeeStats: 1,
+				IsCurrentUserAdmin:     true,
+				IsCurrentUserMember:    true,
+				CreatedAt:              time.Date(2022, 10, 13, 1, 22, 5, 0, time.UTC),
+			},
+		},
+		Total:      1,
+		TotalPages: 1,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestOrgs_GetDashboardMembers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"email": null,
+				"full_name": "Shuhei Yamashita",
+				"id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+				"is_view_only": false,
+				"photo": "https://wakatime.com/photo/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+				"username": "yamash723"
+			}
+		],
+		"total": 1,
+		"total_pages": 1
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/orgs/wakago-org/dashboards/backend/members"
+	httpmock.RegisterResponderWithQuery("GET", url, "page=1", httpmock.NewStringResponder(200, dummyResponse))
+
+	page := 1
+	client := NewClient(nil)
+	res, err := client.OrgsService.GetDashboardMembers(context.Background(), "current", "wakago-org", "backend", &OrgDashboardMembersGetOptions{Page: &page})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := OrgDashboardMembers{
+		Data: []OrgDashboardMembersData{
+			{
+				Id:       "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+				FullName: null.StringFrom("Shuhei Yamashita"),
+				Username: null.StringFrom("yamash723"),
+				Photo:    "https://wakatime.com/photo/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			},
+		},
+		Total:      1,
+		TotalPages: 1,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestOrgs_GetMemberDurations(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"branches": ["main"],
+		"data": [{"duration": 3015.5, "project": "wakago", "time": 1666866121.5}],
+		"start": "2022-10-26T15:00:00Z",
+		"end": "2022-10-27T14:59:59Z",
+		"timezone": "Asia/Tokyo"
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/orgs/wakago-org/dashboards/backend/members/yamash723/durations"
+	httpmock.RegisterResponderWithQuery("GET", url, "Date=2022-10-27", httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.OrgsService.GetMemberDurations(context.Background(), "current", "wakago-org", "backend", "yamash723", &DurationsGetOptions{Date: "2022-10-27"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Equal(t, []DurationsData{{Project: "wakago", Time: 1666866121.5, Duration: 3015.5}}, res.Data)
+}
+
+func TestOrgs_GetAllMemberSummaries(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	membersUrl := "https://wakatime.com/api/v1/users/current/orgs/wakago-org/dashboards/backend/members"
+	httpmock.RegisterResponderWithQuery("GET", membersUrl, "page=1",
+		httpmock.NewStringResponder(200, `{"data": [{"id": "member-1"}, {"id": "member-2"}, {"id": "member-3"}], "total": 5, "total_pages": 2}`))
+	httpmock.RegisterResponderWithQuery("GET", membersUrl, "page=2",
+		httpmock.NewStringResponder(200, `{"data": [{"id": "member-4"}, {"id": "member-5"}], "total": 5, "total_pages": 2}`))
+
+	var (
+		mu       sync.Mutex
+		inFlight int
+		peak     int
+	)
+
+	summariesUrl := `=~^https://wakatime.com/api/v1/users/current/orgs/wakago-org/dashboards/backend/members/([^/]+)/summaries`
+	httpmock.RegisterResponder("GET", summariesUrl, func(request *http.Request) (*http.Response, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		assert.Equal(t, "end=2022-10-27&start=2022-10-21", request.URL.RawQuery)
+
+		memberId, _ := httpmock.GetSubmatch(request, 1)
+		body := fmt.Sprintf(`{"cumulative_total": {"text": "%v"}}`, memberId)
+		return httpmock.NewStringResponse(200, body), nil
+	})
+
+	client := NewClient(nil)
+	opts := &SummariesGetOptions{Start: "2022-10-21", End: "2022-10-27"}
+	res, err := client.OrgsService.GetAllMemberSummaries(context.Background(), "current", "wakago-org", "backend", opts, 2)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, res, 5)
+	for memberId, summaries := range res {
+		assert.Equal(t, memberId, summaries.CumulativeTotal.Text)
+	}
+	assert.LessOrEqual(t, peak, 2)
+	assert.Equal(t, 7, httpmock.GetTotalCallCount())
+}
+
+func TestOrgs_GetAllMemberSummaries_error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	membersUrl := "https://wakatime.com/api/v1/users/current/orgs/wakago-org/dashboards/backend/members"
+	httpmock.RegisterResponderWithQuery("GET", membersUrl, "page=1",
+		httpmock.NewStringResponder(200, `{"data": [{"id": "member-1"}, {"id": "member-2"}], "total": 2, "total_pages": 1}`))
+
+	summariesUrl := `=~^https://wakatime.com/api/v1/users/current/orgs/wakago-org/dashboards/backend/members/([^/]+)/summaries`
+	httpmock.RegisterResponder("GET", summariesUrl, func(request *http.Request) (*http.Response, error) {
+		if strings.Contains(request.URL.Path, "member-2") {
+			return httpmock.NewStringResponse(403, `{"error": "Forbidden"}`), nil
+		}
+
+		return httpmock.NewStringResponse(200, `{}`), nil
+	})
+
+	client := NewClient(nil)
+	res, err := client.OrgsService.GetAllMemberSummaries(context.Background(), "current", "wakago-org", "backend", nil, 1)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}
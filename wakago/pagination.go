@@ -0,0 +1,70 @@
+package wakago
+
+import "context"
+
+// PageIterator walks the pages of a paginated endpoint one request at a time.
+//
+//	it := client.LeadersService.Iterate(nil)
+//	for it.Next(ctx) {
+//		leaders := it.Page()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type PageIterator[T any] struct {
+	fetch    func(ctx context.Context, page int) (*T, *Response, int, error)
+	page     int
+	current  *T
+	response *Response
+	err      error
+}
+
+// newPageIterator returns an iterator starting at page.
+// fetch returns the requested page, its response and the number of the next one, or 0 when it was the last page.
+func newPageIterator[T any](page int, fetch func(ctx context.Context, page int) (*T, *Response, int, error)) *PageIterator[T] {
+	if page < 1 {
+		page = 1
+	}
+
+	return &PageIterator[T]{fetch: fetch, page: page}
+}
+
+// Next fetches the next page and reports whether one was available.
+func (it *PageIterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil || it.page == 0 {
+		return false
+	}
+
+	v, response, next, err := it.fetch(ctx, it.page)
+	it.response = response
+	if err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+
+	it.current = v
+	it.page = next
+	return true
+}
+
+// Page returns the page fetched by the last call to Next.
+func (it *PageIterator[T]) Page() *T {
+	return it.current
+}
+
+// Response returns the API response to the last call to Next, or nil when no request was sent.
+func (it *PageIterator[T]) Response() *Response {
+	return it.response
+}
+
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
+
+func nextPage(page int, totalPages int) int {
+	if page < totalPages {
+		return page + 1
+	}
+
+	return 0
+}
@@ -0,0 +1,48 @@
+package wakago
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageIterator(t *testing.T) {
+	pages := []int{}
+	it := newPageIterator(2, func(ctx context.Context, page int) (*int, *Response, int, error) {
+		pages = append(pages, page)
+		return &page, &Response{Page: page}, nextPage(page, 4), nil
+	})
+
+	for it.Next(context.Background()) {
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []int{2, 3, 4}, pages)
+	assert.Equal(t, 4, *it.Page())
+	assert.Equal(t, 4, it.Response().Page)
+	assert.False(t, it.Next(context.Background()))
+}
+
+func TestPageIterator_error(t *testing.T) {
+	fetchErr := errors.New("fetch error")
+	calls := 0
+	it := newPageIterator(0, func(ctx context.Context, page int) (*int, *Response, int, error) {
+		calls++
+		if page == 2 {
+			return nil, nil, 0, fetchErr
+		}
+
+		return &page, nil, page + 1, nil
+	})
+
+	assert.True(t, it.Next(context.Background()))
+	assert.Equal(t, 1, *it.Page())
+	assert.False(t, it.Next(context.Background()))
+	assert.Nil(t, it.Page())
+	assert.Equal(t, fetchErr, it.Err())
+
+	assert.False(t, it.Next(context.Background()))
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,187 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"gopkg.in/guregu/null.v4"
+)
+
+type PrivateLeaderboardsService service
+
+type PrivateLeaderboards struct {
+	Data       []PrivateLeaderboardData `json:"data"`
+	Total      int                      `json:"total"`
+	TotalPages int                      `json:"total_pages"`
+}
+
+type PrivateLeaderboard struct {
+	Data PrivateLeaderboardData `json:"data"`
+}
+
+type PrivateLeaderboardData struct {
+	Id                        string      `json:"id"`
+	Name                      string      `json:"name"`
+	TimeZone                  null.String `json:"time_zone"`
+	CanDelete                 bool        `json:"can_delete"`
+	CanEdit                   bool        `json:"can_edit"`
+	HasAvailableSeat          bool        `json:"has_available_seat"`
+	MembersCount              int         `json:"members_count"`
+	MembersWithTimezonesCount int         `json:"members_with_timezones_count"`
+	CreatedAt                 time.Time   `json:"created_at"`
+	ModifiedAt                null.Time   `json:"modified_at"`
+}
+
+type PrivateLeaderboardsCreateOptions struct {
+	Name     string  `json:"name"`
+	TimeZone *string `json:"time_zone,omitempty"`
+}
+
+type PrivateLeaderboardsUpdateOptions struct {
+	Name     *string `json:"name,omitempty"`
+	TimeZone *string `json:"time_zone,omitempty"`
+}
+
+func (service *PrivateLeaderboardsService) GetAll(ctx context.Context, userId string) (*PrivateLeaderboards, error) {
+	v, _, err := service.GetAllWithResponse(ctx, userId)
+	return v, err
+}
+
+// GetAllWithResponse is like GetAll, and also returns the API response.
+func (service *PrivateLeaderboardsService) GetAllWithResponse(ctx context.Context, userId string) (*PrivateLeaderboards, *Response, error) {
+	path := fmt.Sprintf("users/%v/leaderboards", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(PrivateLeaderboards)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *PrivateLeaderboardsService) Create(ctx context.Context, userId string, opts *PrivateLeaderboardsCreateOptions) (*PrivateLeaderboard, error) {
+	v, _, err := service.CreateWithResponse(ctx, userId, opts)
+	return v, err
+}
+
+// CreateWithResponse is like Create, and also returns the API response.
+func (service *PrivateLeaderboardsService) CreateWithResponse(ctx context.Context, userId string, opts *PrivateLeaderboardsCreateOptions) (*PrivateLeaderboard, *Response, error) {
+	path := fmt.Sprintf("users/%v/leaderboards", userId)
+
+	request, err := service.client.NewRequest("POST", path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(PrivateLeaderboard)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *PrivateLeaderboardsService) Update(ctx context.Context, userId string, boardId string, opts *PrivateLeaderboardsUpdateOptions) (*PrivateLeaderboard, error) {
+	v, _, err := service.UpdateWithResponse(ctx, userId, boardId, opts)
+	return v, err
+}
+
+// UpdateWithResponse is like Update, and also returns the API response.
+func (service *PrivateLeaderboardsService) UpdateWithResponse(ctx context.Context, userId string, boardId string, opts *PrivateLeaderboardsUpdateOptions) (*PrivateLeaderboard, *Response, error) {
+	path := fmt.Sprintf("users/%v/leaderboards/%v", userId, boardId)
+
+	request, err := service.client.NewRequest("PUT", path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(PrivateLeaderboard)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+func (service *PrivateLeaderboardsService) Delete(ctx context.Context, userId string, boardId string) error {
+	_, err := service.DeleteWithResponse(ctx, userId, boardId)
+	return err
+}
+
+// DeleteWithResponse is like Delete, and also returns the API response.
+func (service *PrivateLeaderboardsService) DeleteWithResponse(ctx context.Context, userId string, boardId string) (*Response, error) {
+	path := fmt.Sprintf("users/%v/leaderboards/%v", userId, boardId)
+
+	request, err := service.client.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.DoWithResponse(ctx, request, nil)
+}
+
+func (service *PrivateLeaderboardsService) GetLeaders(ctx context.Context, userId string, boardId string, opts *LeadersGetOptions) (*Leaders, error) {
+	v, _, err := service.GetLeadersWithResponse(ctx, userId, boardId, opts)
+	return v, err
+}
+
+// GetLeadersWithResponse is like GetLeaders, and also returns the API response.
+func (service *PrivateLeaderboardsService) GetLeadersWithResponse(ctx context.Context, userId string, boardId string, opts *LeadersGetOptions) (*Leaders, *Response, error) {
+	path := fmt.Sprintf("users/%v/leaderboards/%v", userId, boardId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Leaders)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// IterateLeaders returns an iterator over every page of a board's leaders, starting at opts.Page when it is set.
+func (service *PrivateLeaderboardsService) IterateLeaders(userId string, boardId string, opts *LeadersGetOptions) *PageIterator[Leaders] {
+	o := LeadersGetOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	start := 1
+	if o.Page != nil {
+		start = *o.Page
+	}
+
+	return newPageIterator(start, func(ctx context.Context, page int) (*Leaders, *Response, int, error) {
+		o.Page = &page
+
+		v, response, err := service.GetLeadersWithResponse(ctx, userId, boardId, &o)
+		if err != nil {
+			return nil, response, 0, err
+		}
+
+		return v, response, nextPage(page, v.TotalPages), nil
+	})
+}
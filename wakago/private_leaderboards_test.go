@@ -0,0 +1,210 @@
+package wakago
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+const dummyPrivateLeaderboard = `
+{
+	"can_delete": true,
+	"can_edit": true,
+	"created_at": "2022-10-31T11:53:10Z",
+	"has_available_seat": true,
+	"id": "e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+	"members_count": 3,
+	"members_with_timezones_count": 2,
+	"modified_at": null,
+	"name": "Squad A",
+	"time_zone": "Asia/Tokyo"
+}`
+
+var expectedPrivateLeaderboard = PrivateLeaderboardData{
+	Id:                        "e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+	Name:                      "Squad A",
+	TimeZone:                  null.StringFrom("Asia/Tokyo"),
+	CanDelete:                 true,
+	CanEdit:                   true,
+	HasAvailableSeat:          true,
+	MembersCount:              3,
+	MembersWithTimezonesCount: 2,
+	CreatedAt:                 time.Date(2022, 10, 31, 11, 53, 10, 0, time.UTC),
+}
+
+func TestPrivateLeaderboards_GetAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `{"data": [` + dummyPrivateLeaderboard + `], "total": 1, "total_pages": 1}`
+
+	url := "https://wakatime.com/api/v1/users/current/leaderboards"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.PrivateLeaderboardsService.GetAll(context.Background(), "current")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := PrivateLeaderboards{
+		Data:       []PrivateLeaderboardData{expectedPrivateLeaderboard},
+		Total:      1,
+		TotalPages: 1,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestPrivateLeaderboards_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/leaderboards"
+	httpmock.RegisterResponder("POST", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, `{"name":"Squad A","time_zone":"Asia/Tokyo"}`, string(body))
+		assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		return httpmock.NewStringResponse(201, `{"data": `+dummyPrivateLeaderboard+`}`), nil
+	})
+
+	timeZone := "Asia/Tokyo"
+	client := NewClient(nil)
+	res, err := client.PrivateLeaderboardsService.Create(context.Background(), "current", &PrivateLeaderboardsCreateOptions{Name: "Squad A", TimeZone: &timeZone})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &PrivateLeaderboard{Data: expectedPrivateLeaderboard}, res)
+}
+
+func TestPrivateLeaderboards_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/leaderboards/e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	httpmock.RegisterResponder("PUT", url, func(request *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(request.Body)
+		assert.JSONEq(t, `{"name":"Squad A"}`, string(body))
+		return httpmock.NewStringResponse(200, `{"data": `+dummyPrivateLeaderboard+`}`), nil
+	})
+
+	name := "Squad A"
+	client := NewClient(nil)
+	res, err := client.PrivateLeaderboardsService.Update(context.Background(), "current", "e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b", &PrivateLeaderboardsUpdateOptions{Name: &name})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &PrivateLeaderboard{Data: expectedPrivateLeaderboard}, res)
+}
+
+func TestPrivateLeaderboards_Delete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/leaderboards/e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	httpmock.RegisterResponder("DELETE", url, httpmock.NewStringResponder(204, ""))
+
+	client := NewClient(nil)
+	err := client.PrivateLeaderboardsService.Delete(context.Background(), "current", "e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestPrivateLeaderboards_GetLeaders(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"rank": 1,
+				"running_total": {
+					"daily_average": 43200.5,
+					"human_readable_daily_average": "12 hrs",
+					"human_readable_total": "84 hrs",
+					"languages": [],
+					"total_seconds": 302403.5
+				},
+				"user": {
+					"display_name": "yamash723",
+					"id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					"username": "yamash723"
+				}
+			}
+		],
+		"page": 2,
+		"total_pages": 2
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/leaderboards/e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	httpmock.RegisterResponderWithQuery("GET", url, "page=2", httpmock.NewStringResponder(200, dummyResponse))
+
+	page := 2
+	client := NewClient(nil)
+	it := client.PrivateLeaderboardsService.IterateLeaders("current", "e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b", &LeadersGetOptions{Page: &page})
+
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.Nil(t, it.Err())
+
+	expected := Leaders{
+		Data: []LeadersData{
+			{
+				Rank: 1,
+				RunningTotal: LeadersRunningTotal{
+					TotalSeconds:              302403.5,
+					HumanReadableTotal:        "84 hrs",
+					DailyAverage:              43200.5,
+					HumanReadableDailyAverage: "12 hrs",
+					Languages:                 []InsightsItem{},
+				},
+				User: LeadersUser{
+					Id:          "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+					Username:    null.StringFrom("yamash723"),
+					DisplayName: "yamash723",
+				},
+			},
+		},
+		Page:       2,
+		TotalPages: 2,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, it.Page())
+}
+
+func TestPrivateLeaderboards_IterateLeaders_pageOmitted(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://wakatime.com/api/v1/users/current/leaderboards/e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, `{"data": [], "total_pages": 3}`))
+
+	client := NewClient(nil)
+	it := client.PrivateLeaderboardsService.IterateLeaders("current", "e7f1a0b2-3c4d-4e5f-8a9b-0c1d2e3f4a5b", nil)
+
+	pages := 0
+	for it.Next(context.Background()) && pages < 10 {
+		pages++
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
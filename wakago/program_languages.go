@@ -0,0 +1,46 @@
+package wakago
+
+import (
+	"context"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type ProgramLanguagesService service
+
+type ProgramLanguages struct {
+	Data []ProgramLanguagesData `json:"data"`
+}
+
+type ProgramLanguagesData struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Color      null.String `json:"color"`
+	IsVerified bool        `json:"is_verified"`
+	CreatedAt  time.Time   `json:"created_at"`
+	ModifiedAt null.Time   `json:"modified_at"`
+}
+
+func (service *ProgramLanguagesService) Get(ctx context.Context) (*ProgramLanguages, error) {
+	v, _, err := service.GetWithResponse(ctx)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *ProgramLanguagesService) GetWithResponse(ctx context.Context) (*ProgramLanguages, *Response, error) {
+	path := "program_languages"
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(ProgramLanguages)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
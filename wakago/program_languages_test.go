@@ -0,0 +1,69 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestProgramLanguages_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"color": "#00ADD8",
+				"created_at": "2016-11-20T21:39:11Z",
+				"id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+				"is_verified": true,
+				"modified_at": "2022-08-01T10:00:00Z",
+				"name": "Go"
+			},
+			{
+				"color": null,
+				"created_at": "2016-11-20T21:39:11Z",
+				"id": "b1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+				"is_verified": false,
+				"modified_at": null,
+				"name": "Brainfuck"
+			}
+		]
+	}`
+
+	url := "https://wakatime.com/api/v1/program_languages"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.ProgramLanguagesService.Get(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := ProgramLanguages{
+		Data: []ProgramLanguagesData{
+			{
+				ID:         "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+				Name:       "Go",
+				Color:      null.StringFrom("#00ADD8"),
+				IsVerified: true,
+				CreatedAt:  time.Date(2016, 11, 20, 21, 39, 11, 0, time.UTC),
+				ModifiedAt: null.TimeFrom(time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)),
+			},
+			{
+				ID:        "b1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+				Name:      "Brainfuck",
+				CreatedAt: time.Date(2016, 11, 20, 21, 39, 11, 0, time.UTC),
+			},
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
@@ -0,0 +1,73 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type ProjectsService service
+
+type Projects struct {
+	Data []ProjectsData `json:"data"`
+}
+
+type ProjectsData struct {
+	CommitProject
+	Color                         null.String `json:"color"`
+	FirstHeartbeatAt              null.Time   `json:"first_heartbeat_at"`
+	HumanReadableFirstHeartbeatAt null.String `json:"human_readable_first_heartbeat_at"`
+}
+
+func (service *ProjectsService) List(ctx context.Context, userId string, q *string) (*Projects, error) {
+	v, _, err := service.ListWithResponse(ctx, userId, q)
+	return v, err
+}
+
+// ListWithResponse is like List, and also returns the API response.
+func (service *ProjectsService) ListWithResponse(ctx context.Context, userId string, q *string) (*Projects, *Response, error) {
+	path := fmt.Sprintf("users/%v/projects", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if q != nil {
+		qv := request.URL.Query()
+		qv.Add("q", *q)
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Projects)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// Find returns the project named exactly name.
+// Its UrlencodedName can be passed to project-scoped services such as CommitsService.
+func (service *ProjectsService) Find(ctx context.Context, userId string, name string) (*ProjectsData, error) {
+	v, _, err := service.FindWithResponse(ctx, userId, name)
+	return v, err
+}
+
+// FindWithResponse is like Find, and also returns the API response.
+func (service *ProjectsService) FindWithResponse(ctx context.Context, userId string, name string) (*ProjectsData, *Response, error) {
+	projects, response, err := service.ListWithResponse(ctx, userId, &name)
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, project := range projects.Data {
+		if project.Name == name {
+			return &project, response, nil
+		}
+	}
+
+	return nil, response, fmt.Errorf("project %w : %v", ErrNotFound, name)
+}
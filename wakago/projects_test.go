@@ -0,0 +1,166 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestProjects_List(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"badge": "badge",
+				"color": "#00add8",
+				"created_at": "2022-10-22T07:20:27Z",
+				"first_heartbeat_at": "2022-10-22T07:20:27Z",
+				"has_public_url": false,
+				"human_readable_first_heartbeat_at": "Oct 22, 2022, 4:20 PM JST",
+				"human_readable_last_heartbeat_at": "Nov 1, 2022, 8:05 PM JST",
+				"id": "a3e655ff-cd1f-4309-819d-b9d0cfa73abc",
+				"last_heartbeat_at": "2022-11-01T11:05:17Z",
+				"name": "wakago",
+				"repository": {
+					"badge": null,
+					"created_at": "2022-11-01T11:07:32Z",
+					"default_branch": "main",
+					"description": null,
+					"fork_count": 0,
+					"full_name": "yamash723/wakago",
+					"homepage": null,
+					"html_url": "https://github.com/yamash723/wakago",
+					"id": "99875969-e08a-41d6-9129-1c0907b310e8",
+					"image_icon_url": "https://wakatime.com/static/img/integrations/github.png",
+					"is_fork": false,
+					"is_private": false,
+					"last_synced_at": null,
+					"modified_at": null,
+					"name": "wakago",
+					"provider": "github",
+					"star_count": 1,
+					"url": "https://api.github.com/repos/yamash723/wakago",
+					"urlencoded_name": "wakago",
+					"wakatime_project_name": "wakago",
+					"watch_count": 1
+				},
+				"url": "/projects/wakago",
+				"urlencoded_name": "wakago"
+			},
+			{
+				"badge": null,
+				"color": null,
+				"created_at": "2022-10-23T07:20:27Z",
+				"first_heartbeat_at": null,
+				"has_public_url": false,
+				"human_readable_first_heartbeat_at": null,
+				"human_readable_last_heartbeat_at": "Nov 1, 2022, 8:05 PM JST",
+				"id": "b3e655ff-cd1f-4309-819d-b9d0cfa73abc",
+				"last_heartbeat_at": "2022-11-01T11:05:17Z",
+				"name": "wakago-sample",
+				"repository": null,
+				"url": "/projects/wakago-sample",
+				"urlencoded_name": "wakago-sample"
+			}
+		]
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/projects"
+	httpmock.RegisterResponderWithQuery("GET", url, "q=wakago", httpmock.NewStringResponder(200, dummyResponse))
+
+	q := "wakago"
+	client := NewClient(nil)
+	res, err := client.ProjectsService.List(context.Background(), "current", &q)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Projects{
+		Data: []ProjectsData{
+			{
+				CommitProject: CommitProject{
+					Badge:                        null.StringFrom("badge"),
+					CreatedAt:                    time.Date(2022, 10, 22, 7, 20, 27, 0, time.UTC),
+					HasPublicUrl:                 false,
+					HumanReadableLastHeartbeatAt: "Nov 1, 2022, 8:05 PM JST",
+					Id:                           "a3e655ff-cd1f-4309-819d-b9d0cfa73abc",
+					LastHeartbeatAt:              time.Date(2022, 11, 1, 11, 5, 17, 0, time.UTC),
+					Name:                         "wakago",
+					Repository: CommitRepository{
+						CreatedAt:           time.Date(2022, 11, 1, 11, 7, 32, 0, time.UTC),
+						DefaultBranch:       "main",
+						FullName:            "yamash723/wakago",
+						HtmlUrl:             "https://github.com/yamash723/wakago",
+						Id:                  "99875969-e08a-41d6-9129-1c0907b310e8",
+						ImageIconUrl:        "https://wakatime.com/static/img/integrations/github.png",
+						Name:                "wakago",
+						Provider:            "github",
+						StarCount:           1,
+						Url:                 "https://api.github.com/repos/yamash723/wakago",
+						UrlencodedName:      "wakago",
+						WakatimeProjectName: "wakago",
+						WatchCount:          1,
+					},
+					Url:            "/projects/wakago",
+					UrlencodedName: "wakago",
+				},
+				Color:                         null.StringFrom("#00add8"),
+				FirstHeartbeatAt:              null.TimeFrom(time.Date(2022, 10, 22, 7, 20, 27, 0, time.UTC)),
+				HumanReadableFirstHeartbeatAt: null.StringFrom("Oct 22, 2022, 4:20 PM JST"),
+			},
+			{
+				CommitProject: CommitProject{
+					CreatedAt:                    time.Date(2022, 10, 23, 7, 20, 27, 0, time.UTC),
+					HumanReadableLastHeartbeatAt: "Nov 1, 2022, 8:05 PM JST",
+					Id:                           "b3e655ff-cd1f-4309-819d-b9d0cfa73abc",
+					LastHeartbeatAt:              time.Date(2022, 11, 1, 11, 5, 17, 0, time.UTC),
+					Name:                         "wakago-sample",
+					Url:                          "/projects/wakago-sample",
+					UrlencodedName:               "wakago-sample",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
+
+func TestProjects_Find(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{"id": "b3e655ff-cd1f-4309-819d-b9d0cfa73abc", "name": "wakago-sample", "urlencoded_name": "wakago-sample"},
+			{"id": "a3e655ff-cd1f-4309-819d-b9d0cfa73abc", "name": "wakago", "urlencoded_name": "wakago"}
+		]
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/projects"
+	httpmock.RegisterResponderWithQuery("GET", url, "q=wakago", httpmock.NewStringResponder(200, dummyResponse))
+	httpmock.RegisterResponderWithQuery("GET", url, "q=unknown", httpmock.NewStringResponder(200, `{"data": []}`))
+
+	client := NewClient(nil)
+	res, err := client.ProjectsService.Find(context.Background(), "current", "wakago")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "a3e655ff-cd1f-4309-819d-b9d0cfa73abc", res.Id)
+	assert.Equal(t, "wakago", res.UrlencodedName)
+
+	_, err = client.ProjectsService.Find(context.Background(), "current", "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "project not found : unknown")
+}
@@ -0,0 +1,95 @@
+package wakago
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WakaTime allows an average of 10 requests per second over any 5 minute period.
+const (
+	DefaultRateLimit       = 3000
+	DefaultRateLimitWindow = 5 * time.Minute
+)
+
+// WindowRateLimiter allows up to Limit requests in any sliding Window.
+// It is safe for concurrent use, so one limiter can be shared by every client using the same credentials.
+type WindowRateLimiter struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu   sync.Mutex
+	sent []time.Time
+}
+
+// RateLimitBudget is the state of a WindowRateLimiter.
+// Reset is when the next request is allowed again, or the zero time while Remaining is above zero.
+type RateLimitBudget struct {
+	Limit     int
+	Remaining int
+	Window    time.Duration
+	Reset     time.Time
+}
+
+// NewWindowRateLimiter returns a limiter allowing limit requests in any window.
+func NewWindowRateLimiter(limit int, window time.Duration) *WindowRateLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &WindowRateLimiter{limit: limit, window: window, now: time.Now}
+}
+
+// NewDefaultRateLimiter returns a limiter matching the WakaTime rate limit.
+func NewDefaultRateLimiter() *WindowRateLimiter {
+	return NewWindowRateLimiter(DefaultRateLimit, DefaultRateLimitWindow)
+}
+
+// Wait blocks until a request is allowed or ctx is done.
+func (l *WindowRateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := l.now()
+		l.prune(now)
+
+		if len(l.sent) < l.limit {
+			l.sent = append(l.sent, now)
+			l.mu.Unlock()
+			return nil
+		}
+
+		delay := l.sent[0].Add(l.window).Sub(now)
+		l.mu.Unlock()
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// Budget returns how many requests are left in the current window.
+func (l *WindowRateLimiter) Budget() RateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	budget := RateLimitBudget{Limit: l.limit, Remaining: l.limit - len(l.sent), Window: l.window}
+	if budget.Remaining == 0 {
+		budget.Reset = l.sent[0].Add(l.window)
+	}
+
+	return budget
+}
+
+// prune forgets the requests sent before the window ending at now.
+func (l *WindowRateLimiter) prune(now time.Time) {
+	i := 0
+	for i < len(l.sent) && !now.Before(l.sent[i].Add(l.window)) {
+		i++
+	}
+
+	l.sent = l.sent[i:]
+}
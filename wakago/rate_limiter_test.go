@@ -0,0 +1,88 @@
+package wakago
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowRateLimiter_Budget(t *testing.T) {
+	now := time.Date(2022, 10, 25, 0, 0, 0, 0, time.UTC)
+	l := NewWindowRateLimiter(2, time.Minute)
+	l.now = func() time.Time { return now }
+
+	assert.Equal(t, RateLimitBudget{Limit: 2, Remaining: 2, Window: time.Minute}, l.Budget())
+
+	assert.Nil(t, l.Wait(context.Background()))
+	now = now.Add(10 * time.Second)
+	assert.Nil(t, l.Wait(context.Background()))
+
+	assert.Equal(t, RateLimitBudget{Limit: 2, Remaining: 0, Window: time.Minute, Reset: now.Add(50 * time.Second)}, l.Budget())
+
+	now = now.Add(50 * time.Second)
+	assert.Equal(t, RateLimitBudget{Limit: 2, Remaining: 1, Window: time.Minute}, l.Budget())
+}
+
+func TestWindowRateLimiter_Wait(t *testing.T) {
+	l := NewWindowRateLimiter(2, 50*time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, l.Wait(context.Background()))
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestWindowRateLimiter_Wait_contextDone(t *testing.T) {
+	l := NewWindowRateLimiter(1, time.Hour)
+	assert.Nil(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+	assert.Equal(t, 0, l.Budget().Remaining)
+}
+
+func TestWindowRateLimiter_concurrent(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(200, `{}`))
+
+	l := NewWindowRateLimiter(10, time.Hour)
+	c, _ := New(WithRateLimiter(l))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			request, _ := c.NewRequest("GET", "foo", nil)
+			_, err := c.Do(context.Background(), request, nil)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, httpmock.GetTotalCallCount())
+	assert.Equal(t, 0, l.Budget().Remaining)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	request, _ := c.NewRequest("GET", "foo", nil)
+	_, err := c.Do(ctx, request, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 10, httpmock.GetTotalCallCount())
+}
+
+func TestNewDefaultRateLimiter(t *testing.T) {
+	assert.Equal(t, RateLimitBudget{Limit: 3000, Remaining: 3000, Window: 5 * time.Minute}, NewDefaultRateLimiter().Budget())
+}
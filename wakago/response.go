@@ -0,0 +1,92 @@
+package wakago
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response is an API response with its pagination, rate limit and freshness metadata parsed.
+//
+// It is returned by the WithResponse variant of each service method, such as GoalsService.GetWithResponse,
+// and by PageIterator.Response. Methods polling the API return the response to their last request,
+// while CreateBulk, DownloadDays and OrgsService.GetAllMemberSummaries send several requests at once and have no variant.
+type Response struct {
+	*http.Response
+
+	// Pagination of list responses. NextPage and PrevPage are 0 when there is no such page.
+	Page       int
+	TotalPages int
+	NextPage   int
+	PrevPage   int
+	Total      int
+
+	Rate Rate
+
+	// Accepted reports a 202 Accepted response, sent with partial data while WakaTime is still calculating it.
+	Accepted bool
+
+	// CachedAt is when WakaTime cached the data, for responses with a cached_at field.
+	CachedAt     time.Time
+	LastModified time.Time
+	Age          time.Duration
+	CacheControl string
+	ETag         string
+}
+
+// Rate is the rate limit state sent in the X-RateLimit-* and Retry-After headers, where present.
+type Rate struct {
+	Limit      int
+	Remaining  int
+	Reset      time.Time
+	RetryAfter time.Duration
+}
+
+// NewResponse returns a Response with the metadata in the headers of response parsed.
+func NewResponse(response *http.Response) *Response {
+	r := &Response{Response: response, Accepted: response.StatusCode == http.StatusAccepted}
+
+	header := response.Header
+	r.Rate.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	r.Rate.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		r.Rate.Reset = time.Unix(reset, 0)
+	}
+
+	r.Rate.RetryAfter, _ = retryAfter(response, time.Now())
+
+	if lastModified, err := http.ParseTime(header.Get("Last-Modified")); err == nil {
+		r.LastModified = lastModified
+	}
+
+	if age, err := strconv.Atoi(header.Get("Age")); err == nil {
+		r.Age = time.Duration(age) * time.Second
+	}
+
+	r.CacheControl = header.Get("Cache-Control")
+	r.ETag = header.Get("ETag")
+
+	return r
+}
+
+// parseBody reads the pagination and cached_at fields of a JSON body, ignoring any it cannot decode.
+func (r *Response) parseBody(data []byte) {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil {
+		return
+	}
+
+	for name, v := range map[string]interface{}{
+		"page":        &r.Page,
+		"total_pages": &r.TotalPages,
+		"next_page":   &r.NextPage,
+		"prev_page":   &r.PrevPage,
+		"total":       &r.Total,
+		"cached_at":   &r.CachedAt,
+	} {
+		if raw, ok := fields[name]; ok {
+			json.Unmarshal(raw, v)
+		}
+	}
+}
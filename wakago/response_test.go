@@ -0,0 +1,169 @@
+package wakago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewResponse(t *testing.T) {
+	response := &http.Response{
+		StatusCode: 202,
+		Header: http.Header{
+			"X-Ratelimit-Limit":     {"3000"},
+			"X-Ratelimit-Remaining": {"2990"},
+			"X-Ratelimit-Reset":     {"1666656000"},
+			"Retry-After":           {"30"},
+			"Last-Modified":         {"Tue, 25 Oct 2022 00:00:00 GMT"},
+			"Age":                   {"120"},
+			"Cache-Control":         {"max-age=300"},
+			"Etag":                  {`"abc"`},
+		},
+	}
+
+	r := NewResponse(response)
+
+	assert.Same(t, response, r.Response)
+	assert.True(t, r.Accepted)
+	assert.Equal(t, Rate{Limit: 3000, Remaining: 2990, Reset: time.Unix(1666656000, 0), RetryAfter: 30 * time.Second}, r.Rate)
+	assert.Equal(t, time.Date(2022, 10, 25, 0, 0, 0, 0, time.UTC), r.LastModified)
+	assert.Equal(t, 2*time.Minute, r.Age)
+	assert.Equal(t, "max-age=300", r.CacheControl)
+	assert.Equal(t, `"abc"`, r.ETag)
+}
+
+func TestLeaders_GetWithResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [],
+		"next_page": 3,
+		"next_page_url": "https://wakatime.com/api/v1/leaders?page=3",
+		"page": 2,
+		"prev_page": 1,
+		"total": 250,
+		"total_pages": 3
+	}`
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/leaders", func(request *http.Request) (*http.Response, error) {
+		response := httpmock.NewStringResponse(200, dummyResponse)
+		response.Header.Set("X-RateLimit-Remaining", "42")
+		return response, nil
+	})
+
+	client := NewClient(nil)
+	page := 2
+	res, response, err := client.LeadersService.GetWithResponse(context.Background(), &LeadersGetOptions{Page: &page})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, res.Page)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.False(t, response.Accepted)
+	assert.Equal(t, 2, response.Page)
+	assert.Equal(t, 3, response.TotalPages)
+	assert.Equal(t, 3, response.NextPage)
+	assert.Equal(t, 1, response.PrevPage)
+	assert.Equal(t, 250, response.Total)
+	assert.Equal(t, 42, response.Rate.Remaining)
+}
+
+func TestGoals_GetWithResponse_accepted(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/users/current/goals/goal-id",
+		httpmock.NewStringResponder(202, `{"data": {"id": "goal-id"}, "cached_at": "2022-11-01T10:30:16Z"}`))
+
+	client := NewClient(nil)
+	_, response, err := client.GoalsService.GetWithResponse(context.Background(), "current", "goal-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 202, response.StatusCode)
+	assert.True(t, response.Accepted)
+	assert.Equal(t, time.Date(2022, 11, 1, 10, 30, 16, 0, time.UTC), response.CachedAt)
+	assert.Zero(t, response.NextPage)
+}
+
+func TestUsers_GetWithResponse_error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/users/current", func(request *http.Request) (*http.Response, error) {
+		response := httpmock.NewStringResponse(429, `{"error": "Too many requests"}`)
+		response.Header.Set("Retry-After", "60")
+		return response, nil
+	})
+
+	client := NewClient(nil)
+	_, response, err := client.UsersService.GetWithResponse(context.Background(), "current")
+
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, 429, response.StatusCode)
+	assert.Equal(t, time.Minute, response.Rate.RetryAfter)
+}
+
+func TestPrivateLeaderboards_DeleteWithResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("DELETE", "https://wakatime.com/api/v1/users/current/leaderboards/board-id", httpmock.NewStringResponder(204, ``))
+
+	client := NewClient(nil)
+	response, err := client.PrivateLeaderboardsService.DeleteWithResponse(context.Background(), "current", "board-id")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 204, response.StatusCode)
+}
+
+func TestLeaders_Iterate_response(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/leaders", httpmock.NewStringResponder(200, `{"data": [], "page": 1, "total_pages": 1}`))
+
+	client := NewClient(nil)
+	it := client.LeadersService.Iterate(nil)
+
+	assert.Nil(t, it.Response())
+	assert.True(t, it.Next(context.Background()))
+	assert.Equal(t, 1, it.Response().TotalPages)
+}
+
+func TestInsights_WeekdaysWithResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/users/current/insights/weekdays/last_7_days",
+		httpmock.NewStringResponder(202, `{"data": {"weekdays": []}}`))
+
+	client := NewClient(nil)
+	res, response, err := client.InsightsService.WeekdaysWithResponse(context.Background(), "current", StatsRangeLast7Days, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []InsightsWeekday{}, res.Data.Weekdays)
+	assert.True(t, response.Accepted)
+}
+
+func TestDoWithResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo", httpmock.NewStringResponder(200, `{"page": 2}`))
+
+	client := NewClient(nil)
+	request, _ := client.NewRequest("GET", "foo", nil)
+
+	response, err := client.DoWithResponse(context.Background(), request, &struct{}{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, response.Page)
+}
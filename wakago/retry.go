@@ -0,0 +1,186 @@
+package wakago
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// BackoffRetryPolicy retries transient failures with jittered exponential backoff, honouring Retry-After.
+//
+// Idempotent requests such as GET are retried on 429, 5xx and transient network errors.
+// Other requests, such as heartbeat POSTs, are only retried when the server cannot have processed them:
+// on 429 Too Many Requests, or when the connection could not be established.
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the most attempts sent for a request, including the first one.
+	MaxAttempts int
+
+	// The delay before retry n is InitialInterval * Multiplier^(n-1), capped at MaxInterval
+	// and randomized by ±Jitter of itself.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	Jitter          float64
+
+	// MaxElapsedTime stops retrying once the next attempt would start after it. Zero means no limit.
+	MaxElapsedTime time.Duration
+
+	// OnRetry is called before waiting delay to send the request again.
+	OnRetry func(attempt RetryAttempt, delay time.Duration)
+}
+
+// NewBackoffRetryPolicy returns a policy sending up to 5 attempts within 2 minutes, starting with a 500ms delay.
+func NewBackoffRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxAttempts:     5,
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.5,
+		MaxElapsedTime:  2 * time.Minute,
+	}
+}
+
+func (p *BackoffRetryPolicy) Retry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt >= p.MaxAttempts || !retryable(attempt) {
+		return 0, false
+	}
+
+	delay, ok := retryAfter(attempt.Response, time.Now())
+	if !ok {
+		delay = p.backoff(attempt.Attempt)
+	}
+
+	if p.MaxElapsedTime > 0 && attempt.Elapsed+delay > p.MaxElapsedTime {
+		return 0, false
+	}
+
+	if p.OnRetry != nil {
+		p.OnRetry(attempt, delay)
+	}
+
+	return delay, true
+}
+
+func (p *BackoffRetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && delay > float64(p.MaxInterval) {
+		delay = float64(p.MaxInterval)
+	}
+
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(delay)
+}
+
+func retryable(attempt RetryAttempt) bool {
+	idempotent := isIdempotent(attempt.Request.Method)
+
+	if attempt.Err != nil {
+		if !transient(attempt.Err) {
+			return false
+		}
+
+		return idempotent || notSent(attempt.Err)
+	}
+
+	if attempt.Response == nil {
+		return false
+	}
+
+	switch attempt.Response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// transient reports whether err is a network failure worth retrying, such as a timeout or a reset connection.
+// TLS and certificate failures, and errors returned by a custom transport, are not.
+func transient(err error) bool {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var recordErr tls.RecordHeaderError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	if errors.As(err, &recordErr) || errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &hostnameErr) || errors.As(err, &invalidErr) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// notSent reports whether err happened before the request could reach the server.
+func notSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryAfter returns the delay asked by the Retry-After header of response, in seconds or as an HTTP date.
+func retryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	if delay := at.Sub(now); delay > 0 {
+		return delay, true
+	}
+
+	return 0, true
+}
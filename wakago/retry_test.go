@@ -0,0 +1,222 @@
+package wakago
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBackoffRetryPolicy() *BackoffRetryPolicy {
+	p := NewBackoffRetryPolicy()
+	p.InitialInterval = time.Millisecond
+	p.Jitter = 0
+	return p
+}
+
+func retryAttempt(method string, statusCode int, err error) RetryAttempt {
+	request, _ := http.NewRequest(method, "https://wakatime.com/api/v1/foo", nil)
+	a := RetryAttempt{Attempt: 1, Request: request, Err: err}
+	if err == nil {
+		a.Response = &http.Response{StatusCode: statusCode, Header: http.Header{}}
+	}
+
+	return a
+}
+
+func TestBackoffRetryPolicy_Retry(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}
+	resetErr := &url.Error{Op: "Get", URL: "https://wakatime.com/api/v1/foo", Err: syscall.ECONNRESET}
+	certErr := &url.Error{Op: "Get", URL: "https://wakatime.com/api/v1/foo", Err: x509.UnknownAuthorityError{}}
+	otherErr := errors.New("invalid_grant")
+
+	cases := []struct {
+		attempt RetryAttempt
+		retry   bool
+	}{
+		{retryAttempt("GET", 200, nil), false},
+		{retryAttempt("GET", 400, nil), false},
+		{retryAttempt("GET", 404, nil), false},
+		{retryAttempt("GET", 429, nil), true},
+		{retryAttempt("GET", 500, nil), true},
+		{retryAttempt("GET", 503, nil), true},
+		{retryAttempt("GET", 0, readErr), true},
+		{retryAttempt("GET", 0, resetErr), true},
+		{retryAttempt("GET", 0, certErr), false},
+		{retryAttempt("GET", 0, otherErr), false},
+		{retryAttempt("DELETE", 502, nil), true},
+		{retryAttempt("POST", 429, nil), true},
+		{retryAttempt("POST", 500, nil), false},
+		{retryAttempt("POST", 0, dialErr), true},
+		{retryAttempt("POST", 0, readErr), false},
+	}
+
+	p := newTestBackoffRetryPolicy()
+	for _, c := range cases {
+		_, retry := p.Retry(c.attempt)
+		assert.Equal(t, c.retry, retry, "%v %v %v", c.attempt.Request.Method, c.attempt.Response, c.attempt.Err)
+	}
+}
+
+func TestBackoffRetryPolicy_backoff(t *testing.T) {
+	p := NewBackoffRetryPolicy()
+	p.Jitter = 0
+
+	assert.Equal(t, 500*time.Millisecond, p.backoff(1))
+	assert.Equal(t, time.Second, p.backoff(2))
+	assert.Equal(t, 4*time.Second, p.backoff(4))
+	assert.Equal(t, 30*time.Second, p.backoff(10))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := p.backoff(2)
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+	}
+}
+
+func TestBackoffRetryPolicy_limits(t *testing.T) {
+	p := newTestBackoffRetryPolicy()
+
+	a := retryAttempt("GET", 503, nil)
+	a.Attempt = 5
+	_, retry := p.Retry(a)
+	assert.False(t, retry)
+
+	a = retryAttempt("GET", 503, nil)
+	a.Elapsed = 2 * time.Minute
+	_, retry = p.Retry(a)
+	assert.False(t, retry)
+}
+
+func TestBackoffRetryPolicy_retryAfter(t *testing.T) {
+	p := newTestBackoffRetryPolicy()
+
+	a := retryAttempt("POST", 429, nil)
+	a.Response.Header.Set("Retry-After", "7")
+	delay, retry := p.Retry(a)
+	assert.True(t, retry)
+	assert.Equal(t, 7*time.Second, delay)
+
+	a.Response.Header.Set("Retry-After", "600")
+	_, retry = p.Retry(a)
+	assert.False(t, retry)
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2022, 10, 25, 0, 0, 0, 0, time.UTC)
+	response := &http.Response{Header: http.Header{}}
+
+	_, ok := retryAfter(response, now)
+	assert.False(t, ok)
+
+	response.Header.Set("Retry-After", "120")
+	delay, ok := retryAfter(response, now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, delay)
+
+	response.Header.Set("Retry-After", "Tue, 25 Oct 2022 00:00:30 GMT")
+	delay, ok = retryAfter(response, now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, delay)
+
+	response.Header.Set("Retry-After", "soon")
+	_, ok = retryAfter(response, now)
+	assert.False(t, ok)
+}
+
+func TestBackoffRetryPolicy_Do(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/users/current", func(request *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			response := httpmock.NewStringResponse(429, `{"error": "Too many requests"}`)
+			response.Header.Set("Retry-After", "0")
+			return response, nil
+		}
+
+		if calls == 2 {
+			return httpmock.NewStringResponse(503, `{}`), nil
+		}
+
+		return httpmock.NewStringResponse(200, `{"data": {"id": "user-id"}}`), nil
+	})
+
+	var retries []int
+	p := newTestBackoffRetryPolicy()
+	p.OnRetry = func(attempt RetryAttempt, delay time.Duration) {
+		retries = append(retries, attempt.Response.StatusCode)
+	}
+
+	c, _ := New(WithRetryPolicy(p))
+	user, err := c.UsersService.Get(context.Background(), "current")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "user-id", user.Data.Id)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []int{429, 503}, retries)
+}
+
+func TestBackoffRetryPolicy_Do_heartbeatNotRetried(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://wakatime.com/api/v1/users/current/heartbeats", httpmock.NewStringResponder(502, `{}`))
+
+	c, _ := New(WithRetryPolicy(newTestBackoffRetryPolicy()))
+	_, err := c.HeartbeatsService.Create(context.Background(), &HeartbeatsCreateOptions{Entity: "main.go", Type: "file", Time: 1666656000})
+
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestBackoffRetryPolicy_Do_authenticatorError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	authentications, retries := 0, 0
+	authenticator := AuthenticatorFunc(func(request *http.Request) error {
+		authentications++
+		return errors.New("invalid_grant")
+	})
+
+	p := newTestBackoffRetryPolicy()
+	p.OnRetry = func(attempt RetryAttempt, delay time.Duration) { retries++ }
+
+	c, _ := New(WithAuthenticator(authenticator), WithRetryPolicy(p))
+	_, err := c.UsersService.Get(context.Background(), "current")
+
+	assert.EqualError(t, err, "invalid_grant")
+	assert.Equal(t, 1, authentications)
+	assert.Equal(t, 0, retries)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestBackoffRetryPolicy_Do_rateLimiterError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	limiter := &testRateLimiter{err: errors.New("limited")}
+	policy := &testRetryPolicy{max: 5}
+
+	c, _ := New(WithRateLimiter(limiter), WithRetryPolicy(policy))
+	_, err := c.UsersService.Get(context.Background(), "current")
+
+	assert.EqualError(t, err, "limited")
+	assert.Equal(t, 1, limiter.calls)
+	assert.Empty(t, policy.attempts)
+}
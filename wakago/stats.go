@@ -0,0 +1,182 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+type StatsService service
+
+type StatsRange string
+
+const (
+	StatsRangeLast7Days   StatsRange = "last_7_days"
+	StatsRangeLast30Days  StatsRange = "last_30_days"
+	StatsRangeLast6Months StatsRange = "last_6_months"
+	StatsRangeLastYear    StatsRange = "last_year"
+	StatsRangeAllTime     StatsRange = "all_time"
+)
+
+func StatsRangeYear(year int) StatsRange {
+	return StatsRange(fmt.Sprintf("%04d", year))
+}
+
+func StatsRangeMonth(year int, month time.Month) StatsRange {
+	return StatsRange(fmt.Sprintf("%04d-%02d", year, int(month)))
+}
+
+type Stats struct {
+	Data StatsData `json:"data"`
+}
+
+type StatsData struct {
+	Id                                              string             `json:"id"`
+	UserId                                          string             `json:"user_id"`
+	Username                                        string             `json:"username"`
+	Range                                           string             `json:"range"`
+	HumanReadableRange                              string             `json:"human_readable_range"`
+	Start                                           time.Time          `json:"start"`
+	End                                             time.Time          `json:"end"`
+	Timezone                                        string             `json:"timezone"`
+	Timeout                                         int                `json:"timeout"`
+	WritesOnly                                      bool               `json:"writes_only"`
+	Status                                          string             `json:"status"`
+	IsUpToDate                                      bool               `json:"is_up_to_date"`
+	PercentCalculated                               int                `json:"percent_calculated"`
+	IsAlreadyUpdating                               bool               `json:"is_already_updating"`
+	IsStuck                                         bool               `json:"is_stuck"`
+	IsIncludingToday                                bool               `json:"is_including_today"`
+	IsCodingActivityVisible                         bool               `json:"is_coding_activity_visible"`
+	IsOtherUsageVisible                             bool               `json:"is_other_usage_visible"`
+	Holidays                                        int                `json:"holidays"`
+	DaysIncludingHolidays                           int                `json:"days_including_holidays"`
+	DaysMinusHolidays                               int                `json:"days_minus_holidays"`
+	TotalSeconds                                    float64            `json:"total_seconds"`
+	TotalSecondsIncludingOtherLanguage              float64            `json:"total_seconds_including_other_language"`
+	HumanReadableTotal                              string             `json:"human_readable_total"`
+	HumanReadableTotalIncludingOtherLanguage        string             `json:"human_readable_total_including_other_language"`
+	DailyAverage                                    float64            `json:"daily_average"`
+	DailyAverageIncludingOtherLanguage              float64            `json:"daily_average_including_other_language"`
+	HumanReadableDailyAverage                       string             `json:"human_readable_daily_average"`
+	HumanReadableDailyAverageIncludingOtherLanguage string             `json:"human_readable_daily_average_including_other_language"`
+	BestDay                                         StatsBestDay       `json:"best_day"`
+	Categories                                      []StatsItem        `json:"categories"`
+	Dependencies                                    []StatsItem        `json:"dependencies"`
+	Editors                                         []StatsItem        `json:"editors"`
+	Languages                                       []StatsItem        `json:"languages"`
+	Machines                                        []StatsMachineItem `json:"machines"`
+	OperatingSystems                                []StatsItem        `json:"operating_systems"`
+	Projects                                        []StatsItem        `json:"projects"`
+	CreatedAt                                       time.Time          `json:"created_at"`
+	ModifiedAt                                      time.Time          `json:"modified_at"`
+}
+
+type StatsBestDay struct {
+	Date         string  `json:"date"`
+	Text         string  `json:"text"`
+	TotalSeconds float64 `json:"total_seconds"`
+}
+
+type StatsItem struct {
+	Name         string  `json:"name"`
+	TotalSeconds float64 `json:"total_seconds"`
+	Percent      float64 `json:"percent"`
+	Digital      string  `json:"digital"`
+	Decimal      string  `json:"decimal"`
+	Text         string  `json:"text"`
+	Hours        int     `json:"hours"`
+	Minutes      int     `json:"minutes"`
+	Seconds      int     `json:"seconds"`
+}
+
+type StatsMachineItem struct {
+	StatsItem
+	MachineNameId string `json:"machine_name_id"`
+}
+
+type StatsGetOptions struct {
+	Timeout    *int  `url:"timeout,omitempty"`
+	WritesOnly *bool `url:"writes_only,omitempty"`
+}
+
+// IsComplete reports whether WakaTime has finished calculating the stats.
+// While the stats are being calculated the API responds with 202 Accepted and partial data.
+func (s *Stats) IsComplete() bool {
+	return s.Data.IsUpToDate && s.Data.PercentCalculated >= 100
+}
+
+func (service *StatsService) Get(ctx context.Context, userId string, statsRange StatsRange, opts *StatsGetOptions) (*Stats, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, statsRange, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *StatsService) GetWithResponse(ctx context.Context, userId string, statsRange StatsRange, opts *StatsGetOptions) (*Stats, *Response, error) {
+	path := fmt.Sprintf("users/%v/stats/%v", userId, statsRange)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Stats)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
+
+// GetComplete calls Get every interval until the stats are complete.
+// When ctx ends first, the most recent partial stats are returned together with the context error.
+func (service *StatsService) GetComplete(ctx context.Context, userId string, statsRange StatsRange, opts *StatsGetOptions, interval time.Duration) (*Stats, error) {
+	v, _, err := service.GetCompleteWithResponse(ctx, userId, statsRange, opts, interval)
+	return v, err
+}
+
+// GetCompleteWithResponse is like GetComplete, and also returns the API response to the last request.
+func (service *StatsService) GetCompleteWithResponse(ctx context.Context, userId string, statsRange StatsRange, opts *StatsGetOptions, interval time.Duration) (*Stats, *Response, error) {
+	if interval <= 0 {
+		return nil, nil, fmt.Errorf("interval must be positive : %v", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *Stats
+	var lastResponse *Response
+	for {
+		v, response, err := service.GetWithResponse(ctx, userId, statsRange, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, lastResponse, ctx.Err()
+			}
+
+			return nil, response, err
+		}
+
+		if v.IsComplete() {
+			return v, response, nil
+		}
+		last, lastResponse = v, response
+
+		select {
+		case <-ctx.Done():
+			return last, lastResponse, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package wakago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsRange(t *testing.T) {
+	assert.Equal(t, StatsRange("2022"), StatsRangeYear(2022))
+	assert.Equal(t, StatsRange("2022-03"), StatsRangeMonth(2022, time.March))
+}
+
+func TestStats_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": {
+			"best_day": {
+				"date": "2022-10-25",
+				"text": "2 hrs 42 mins",
+				"total_seconds": 9757.783427
+			},
+			"categories": [
+				{
+					"decimal": "12.80",
+					"digital": "12:48",
+					"hours": 12,
+					"minutes": 48,
+					"name": "Coding",
+					"percent": 100,
+					"seconds": 21,
+					"text": "12 hrs 48 mins",
+					"total_seconds": 46101.533187
+				}
+			],
+			"created_at": "2022-10-13T01:22:05Z",
+			"daily_average": 6585.933312,
+			"daily_average_including_other_language": 6585.933312,
+			"days_including_holidays": 7,
+			"days_minus_holidays": 7,
+			"dependencies": [],
+			"editors": [
+				{
+					"decimal": "12.80",
+					"digital": "12:48",
+					"hours": 12,
+					"minutes": 48,
+					"name": "VS Code",
+					"percent": 100,
+					"seconds": 21,
+					"text": "12 hrs 48 mins",
+					"total_seconds": 46101.533187
+				}
+			],
+			"end": "2022-10-27T14:59:59Z",
+			"holidays": 0,
+			"human_readable_daily_average": "1 hr 49 mins",
+			"human_readable_daily_average_including_other_language": "1 hr 49 mins",
+			"human_readable_range": "last 7 days",
+			"human_readable_total": "12 hrs 48 mins",
+			"human_readable_total_including_other_language": "12 hrs 48 mins",
+			"id": "b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e",
+			"is_already_updating": false,
+			"is_coding_activity_visible": true,
+			"is_including_today": true,
+			"is_other_usage_visible": true,
+			"is_stuck": false,
+			"is_up_to_date": true,
+			"languages": [
+				{
+					"decimal": "12.80",
+					"digital": "12:48",
+					"hours": 12,
+					"minutes": 48,
+					"name": "Go",
+					"percent": 100,
+					"seconds": 21,
+					"text": "12 hrs 48 mins",
+					"total_seconds": 46101.533187
+				}
+			],
+			"machines": [
+				{
+					"decimal": "12.80",
+					"digital": "12:48",
+					"hours": 12,
+					"machine_name_id": "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+					"minutes": 48,
+					"name": "macbook",
+					"percent": 100,
+					"seconds": 21,
+					"text": "12 hrs 48 mins",
+					"total_seconds": 46101.533187
+				}
+			],
+			"modified_at": "2022-10-27T10:30:16Z",
+			"operating_systems": [
+				{
+					"decimal": "12.80",
+					"digital": "12:48",
+					"hours": 12,
+					"minutes": 48,
+					"name": "Mac",
+					"percent": 100,
+					"seconds": 21,
+					"text": "12 hrs 48 mins",
+					"total_seconds": 46101.533187
+				}
+			],
+			"percent_calculated": 100,
+			"projects": [
+				{
+					"decimal": "12.80",
+					"digital": "12:48",
+					"hours": 12,
+					"minutes": 48,
+					"name": "wakago",
+					"percent": 100,
+					"seconds": 21,
+					"text": "12 hrs 48 mins",
+					"total_seconds": 46101.533187
+				}
+			],
+			"range": "last_7_days",
+			"start": "2022-10-20T15:00:00Z",
+			"status": "ok",
+			"timeout": 15,
+			"timezone": "Asia/Tokyo",
+			"total_seconds": 46101.533187,
+			"total_seconds_including_other_language": 46101.533187,
+			"user_id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			"username": "yamash723",
+			"writes_only": false
+		}
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/stats/last_7_days"
+	httpmock.RegisterResponderWithQuery("GET", url, "timeout=15", httpmock.NewStringResponder(200, dummyResponse))
+
+	timeout := 15
+	client := NewClient(nil)
+	res, err := client.StatsService.Get(context.Background(), "current", StatsRangeLast7Days, &StatsGetOptions{Timeout: &timeout})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := StatsItem{
+		Decimal:      "12.80",
+		Digital:      "12:48",
+		Hours:        12,
+		Minutes:      48,
+		Percent:      100,
+		Seconds:      21,
+		Text:         "12 hrs 48 mins",
+		TotalSeconds: 46101.533187,
+	}
+	named := func(name string) StatsItem {
+		i := item
+		i.Name = name
+		return i
+	}
+
+	expected := Stats{
+		Data: StatsData{
+			Id:                                       "b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e",
+			UserId:                                   "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			Username:                                 "yamash723",
+			Range:                                    "last_7_days",
+			HumanReadableRange:                       "last 7 days",
+			Start:                                    time.Date(2022, 10, 20, 15, 0, 0, 0, time.UTC),
+			End:                                      time.Date(2022, 10, 27, 14, 59, 59, 0, time.UTC),
+			Timezone:                                 "Asia/Tokyo",
+			Timeout:                                  15,
+			Status:                                   "ok",
+			IsUpToDate:                               true,
+			PercentCalculated:                        100,
+			IsIncludingToday:                         true,
+			IsCodingActivityVisible:                  true,
+			IsOtherUsageVisible:                      true,
+			DaysIncludingHolidays:                    7,
+			DaysMinusHolidays:                        7,
+			TotalSeconds:                             46101.533187,
+			TotalSecondsIncludingOtherLanguage:       46101.533187,
+			HumanReadableTotal:                       "12 hrs 48 mins",
+			HumanReadableTotalIncludingOtherLanguage: "12 hrs 48 mins",
+			DailyAverage:                             6585.933312,
+			DailyAverageIncludingOtherLanguage:       6585.933312,
+			HumanReadableDailyAverage:                "1 hr 49 mins",
+			HumanReadableDailyAverageIncludingOtherLanguage: "1 hr 49 mins",
+			BestDay: StatsBestDay{
+				Date:         "2022-10-25",
+				Text:         "2 hrs 42 mins",
+				TotalSeconds: 9757.783427,
+			},
+			Categories:   []StatsItem{named("Coding")},
+			Dependencies: []StatsItem{},
+			Editors:      []StatsItem{named("VS Code")},
+			Languages:    []StatsItem{named("Go")},
+			Machines: []StatsMachineItem{
+				{StatsItem: named("macbook"), MachineNameId: "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e"},
+			},
+			OperatingSystems: []StatsItem{named("Mac")},
+			Projects:         []StatsItem{named("wakago")},
+			CreatedAt:        time.Date(2022, 10, 13, 1, 22, 5, 0, time.UTC),
+			ModifiedAt:       time.Date(2022, 10, 27, 10, 30, 16, 0, time.UTC),
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+	assert.True(t, res.IsComplete())
+}
+
+func TestStats_GetComplete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calculating := `{"data": {"is_up_to_date": false, "percent_calculated": 40, "status": "pending_update"}}`
+	completed := `{"data": {"is_up_to_date": true, "percent_calculated": 100, "status": "ok"}}`
+
+	calls := 0
+	url := "https://wakatime.com/api/v1/users/current/stats/all_time"
+	httpmock.RegisterResponder("GET", url, func(request *http.Request) (*http.Response, error) {
+		calls++
+		if calls < 3 {
+			return httpmock.NewStringResponse(202, calculating), nil
+		}
+
+		return httpmock.NewStringResponse(200, completed), nil
+	})
+
+	client := NewClient(nil)
+	res, err := client.StatsService.GetComplete(context.Background(), "current", StatsRangeAllTime, nil, time.Millisecond)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+	assert.True(t, res.IsComplete())
+	assert.Equal(t, "ok", res.Data.Status)
+}
+
+func TestStats_GetComplete_contextDone(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calculating := `{"data": {"is_up_to_date": false, "percent_calculated": 40, "status": "pending_update"}}`
+
+	url := "https://wakatime.com/api/v1/users/current/stats/all_time"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(202, calculating))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	client := NewClient(nil)
+	res, err := client.StatsService.GetComplete(ctx, "current", StatsRangeAllTime, nil, 5*time.Millisecond)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, res.IsComplete())
+	assert.Equal(t, 40, res.Data.PercentCalculated)
+}
+
+func TestStats_GetComplete_invalidInterval(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client := NewClient(nil)
+	_, err := client.StatsService.GetComplete(context.Background(), "current", StatsRangeAllTime, nil, 0)
+
+	assert.EqualError(t, err, "interval must be positive : 0s")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,58 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type StatusBarService service
+
+type StatusBar struct {
+	Data            StatusBarData `json:"data"`
+	CachedAt        time.Time     `json:"cached_at"`
+	HasTeamFeatures bool          `json:"has_team_features"`
+}
+
+type StatusBarData struct {
+	GrandTotal        SummariesGrandTotal `json:"grand_total"`
+	IsUpToDate        bool                `json:"is_up_to_date"`
+	PercentCalculated int                 `json:"percent_calculated"`
+	Range             GoalRange           `json:"range"`
+	Categories        []StatsItem         `json:"categories"`
+	Dependencies      []StatsItem         `json:"dependencies"`
+	Editors           []StatsItem         `json:"editors"`
+	Languages         []StatsItem         `json:"languages"`
+	Machines          []StatsMachineItem  `json:"machines"`
+	OperatingSystems  []StatsItem         `json:"operating_systems"`
+	Projects          []StatsItem         `json:"projects"`
+}
+
+// IsComplete reports whether today's total has been fully calculated.
+// A cached total may be returned while WakaTime is still updating it in the background.
+func (s *StatusBar) IsComplete() bool {
+	return s.Data.IsUpToDate && s.Data.PercentCalculated >= 100
+}
+
+func (service *StatusBarService) Today(ctx context.Context, userId string) (*StatusBar, error) {
+	v, _, err := service.TodayWithResponse(ctx, userId)
+	return v, err
+}
+
+// TodayWithResponse is like Today, and also returns the API response.
+func (service *StatusBarService) TodayWithResponse(ctx context.Context, userId string) (*StatusBar, *Response, error) {
+	path := fmt.Sprintf("users/%v/status_bar/today", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(StatusBar)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
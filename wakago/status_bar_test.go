@@ -0,0 +1,116 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusBar_Today(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"cached_at": "2022-10-27T10:30:16Z",
+		"data": {
+			"categories": [
+				{
+					"decimal": "1.32",
+					"digital": "1:19",
+					"hours": 1,
+					"minutes": 19,
+					"name": "Coding",
+					"percent": 100,
+					"seconds": 5,
+					"text": "1 hr 19 mins",
+					"total_seconds": 4745.996201
+				}
+			],
+			"dependencies": [],
+			"editors": [],
+			"grand_total": {
+				"decimal": "1.32",
+				"digital": "1:19",
+				"hours": 1,
+				"minutes": 19,
+				"text": "1 hr 19 mins",
+				"total_seconds": 4745.996201
+			},
+			"is_up_to_date": false,
+			"languages": [],
+			"machines": [],
+			"operating_systems": [],
+			"percent_calculated": 80,
+			"projects": [],
+			"range": {
+				"date": "2022-10-27",
+				"end": "2022-10-27T14:59:59Z",
+				"start": "2022-10-26T15:00:00Z",
+				"text": "Thu Oct 27th 2022",
+				"timezone": "Asia/Tokyo"
+			}
+		},
+		"has_team_features": false
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/status_bar/today"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.StatusBarService.Today(context.Background(), "current")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := StatusBar{
+		CachedAt: time.Date(2022, 10, 27, 10, 30, 16, 0, time.UTC),
+		Data: StatusBarData{
+			GrandTotal: SummariesGrandTotal{
+				Decimal:      "1.32",
+				Digital:      "1:19",
+				Hours:        1,
+				Minutes:      19,
+				Text:         "1 hr 19 mins",
+				TotalSeconds: 4745.996201,
+			},
+			IsUpToDate:        false,
+			PercentCalculated: 80,
+			Range: GoalRange{
+				Date:     "2022-10-27",
+				End:      time.Date(2022, 10, 27, 14, 59, 59, 0, time.UTC),
+				Start:    time.Date(2022, 10, 26, 15, 0, 0, 0, time.UTC),
+				Text:     "Thu Oct 27th 2022",
+				Timezone: "Asia/Tokyo",
+			},
+			Categories: []StatsItem{
+				{
+					Decimal:      "1.32",
+					Digital:      "1:19",
+					Hours:        1,
+					Minutes:      19,
+					Name:         "Coding",
+					Percent:      100,
+					Seconds:      5,
+					Text:         "1 hr 19 mins",
+					TotalSeconds: 4745.996201,
+				},
+			},
+			Dependencies:     []StatsItem{},
+			Editors:          []StatsItem{},
+			Languages:        []StatsItem{},
+			Machines:         []StatsMachineItem{},
+			OperatingSystems: []StatsItem{},
+			Projects:         []StatsItem{},
+		},
+		HasTeamFeatures: false,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+	assert.False(t, res.IsComplete())
+}
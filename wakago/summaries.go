@@ -0,0 +1,101 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+type SummariesService service
+
+type Summaries struct {
+	Data            []SummariesData          `json:"data"`
+	CumulativeTotal SummariesCumulativeTotal `json:"cumulative_total"`
+	DailyAverage    SummariesDailyAverage    `json:"daily_average"`
+	Start           time.Time                `json:"start"`
+	End             time.Time                `json:"end"`
+}
+
+type SummariesData struct {
+	GrandTotal       SummariesGrandTotal `json:"grand_total"`
+	Range            GoalRange           `json:"range"`
+	Categories       []StatsItem         `json:"categories"`
+	Projects         []StatsItem         `json:"projects"`
+	Languages        []StatsItem         `json:"languages"`
+	Editors          []StatsItem         `json:"editors"`
+	OperatingSystems []StatsItem         `json:"operating_systems"`
+	Dependencies     []StatsItem         `json:"dependencies"`
+	Machines         []StatsMachineItem  `json:"machines"`
+	Branches         []StatsItem         `json:"branches"`
+	Entities         []StatsItem         `json:"entities"`
+}
+
+type SummariesGrandTotal struct {
+	Decimal      string  `json:"decimal"`
+	Digital      string  `json:"digital"`
+	Hours        int     `json:"hours"`
+	Minutes      int     `json:"minutes"`
+	Text         string  `json:"text"`
+	TotalSeconds float64 `json:"total_seconds"`
+}
+
+type SummariesCumulativeTotal struct {
+	Decimal string  `json:"decimal"`
+	Digital string  `json:"digital"`
+	Seconds float64 `json:"seconds"`
+	Text    string  `json:"text"`
+}
+
+type SummariesDailyAverage struct {
+	Holidays                      int     `json:"holidays"`
+	DaysIncludingHolidays         int     `json:"days_including_holidays"`
+	DaysMinusHolidays             int     `json:"days_minus_holidays"`
+	Seconds                       float64 `json:"seconds"`
+	Text                          string  `json:"text"`
+	SecondsIncludingOtherLanguage float64 `json:"seconds_including_other_language"`
+	TextIncludingOtherLanguage    string  `json:"text_including_other_language"`
+}
+
+type SummariesGetOptions struct {
+	Start      string  `url:"start"`
+	End        string  `url:"end"`
+	Project    *string `url:"project,omitempty"`
+	Branches   *string `url:"branches,omitempty"`
+	Timeout    *int    `url:"timeout,omitempty"`
+	WritesOnly *bool   `url:"writes_only,omitempty"`
+	Timezone   *string `url:"timezone,omitempty"`
+}
+
+func (service *SummariesService) Get(ctx context.Context, userId string, opts *SummariesGetOptions) (*Summaries, error) {
+	v, _, err := service.GetWithResponse(ctx, userId, opts)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *SummariesService) GetWithResponse(ctx context.Context, userId string, opts *SummariesGetOptions) (*Summaries, *Response, error) {
+	path := fmt.Sprintf("users/%v/summaries", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil {
+		qv, err := query.Values(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request.URL.RawQuery = qv.Encode()
+	}
+
+	v := new(Summaries)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
@@ -0,0 +1,194 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaries_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"cumulative_total": {
+			"decimal": "2.70",
+			"digital": "2:42",
+			"seconds": 9757.783427,
+			"text": "2 hrs 42 mins"
+		},
+		"daily_average": {
+			"days_including_holidays": 1,
+			"days_minus_holidays": 1,
+			"holidays": 0,
+			"seconds": 9757,
+			"seconds_including_other_language": 9757,
+			"text": "2 hrs 42 mins",
+			"text_including_other_language": "2 hrs 42 mins"
+		},
+		"data": [
+			{
+				"branches": [
+					{
+						"decimal": "2.70",
+						"digital": "2:42",
+						"hours": 2,
+						"minutes": 42,
+						"name": "main",
+						"percent": 100,
+						"seconds": 37,
+						"text": "2 hrs 42 mins",
+						"total_seconds": 9757.783427
+					}
+				],
+				"categories": [
+					{
+						"decimal": "2.70",
+						"digital": "2:42",
+						"hours": 2,
+						"minutes": 42,
+						"name": "Coding",
+						"percent": 100,
+						"seconds": 37,
+						"text": "2 hrs 42 mins",
+						"total_seconds": 9757.783427
+					}
+				],
+				"dependencies": [],
+				"editors": [],
+				"entities": [],
+				"grand_total": {
+					"decimal": "2.70",
+					"digital": "2:42",
+					"hours": 2,
+					"minutes": 42,
+					"text": "2 hrs 42 mins",
+					"total_seconds": 9757.783427
+				},
+				"languages": [],
+				"machines": [
+					{
+						"decimal": "2.70",
+						"digital": "2:42",
+						"hours": 2,
+						"machine_name_id": "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e",
+						"minutes": 42,
+						"name": "macbook",
+						"percent": 100,
+						"seconds": 37,
+						"text": "2 hrs 42 mins",
+						"total_seconds": 9757.783427
+					}
+				],
+				"operating_systems": [],
+				"projects": [],
+				"range": {
+					"date": "2022-10-25",
+					"end": "2022-10-25T14:59:59Z",
+					"start": "2022-10-24T15:00:00Z",
+					"text": "Tue Oct 25th 2022",
+					"timezone": "Asia/Tokyo"
+				}
+			}
+		],
+		"end": "2022-10-25T14:59:59Z",
+		"start": "2022-10-24T15:00:00Z"
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/summaries"
+	project, timezone := "wakago", "Asia/Tokyo"
+
+	opts := SummariesGetOptions{
+		Start:    "2022-10-25",
+		End:      "2022-10-25",
+		Project:  &project,
+		Timezone: &timezone,
+	}
+	qv, err := query.Values(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedQuery := qv.Encode()
+	httpmock.RegisterResponderWithQuery("GET", url, expectedQuery, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.SummariesService.Get(context.Background(), "current", &opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := StatsItem{
+		Decimal:      "2.70",
+		Digital:      "2:42",
+		Hours:        2,
+		Minutes:      42,
+		Percent:      100,
+		Seconds:      37,
+		Text:         "2 hrs 42 mins",
+		TotalSeconds: 9757.783427,
+	}
+	named := func(name string) StatsItem {
+		i := item
+		i.Name = name
+		return i
+	}
+
+	expected := Summaries{
+		Data: []SummariesData{
+			{
+				GrandTotal: SummariesGrandTotal{
+					Decimal:      "2.70",
+					Digital:      "2:42",
+					Hours:        2,
+					Minutes:      42,
+					Text:         "2 hrs 42 mins",
+					TotalSeconds: 9757.783427,
+				},
+				Range: GoalRange{
+					Date:     "2022-10-25",
+					End:      time.Date(2022, 10, 25, 14, 59, 59, 0, time.UTC),
+					Start:    time.Date(2022, 10, 24, 15, 0, 0, 0, time.UTC),
+					Text:     "Tue Oct 25th 2022",
+					Timezone: "Asia/Tokyo",
+				},
+				Categories:       []StatsItem{named("Coding")},
+				Projects:         []StatsItem{},
+				Languages:        []StatsItem{},
+				Editors:          []StatsItem{},
+				OperatingSystems: []StatsItem{},
+				Dependencies:     []StatsItem{},
+				Machines: []StatsMachineItem{
+					{StatsItem: named("macbook"), MachineNameId: "f4f3e1a9-7dd4-4a4e-9fb4-0f4f5a1b6a3e"},
+				},
+				Branches: []StatsItem{named("main")},
+				Entities: []StatsItem{},
+			},
+		},
+		CumulativeTotal: SummariesCumulativeTotal{
+			Decimal: "2.70",
+			Digital: "2:42",
+			Seconds: 9757.783427,
+			Text:    "2 hrs 42 mins",
+		},
+		DailyAverage: SummariesDailyAverage{
+			DaysIncludingHolidays:         1,
+			DaysMinusHolidays:             1,
+			Seconds:                       9757,
+			SecondsIncludingOtherLanguage: 9757,
+			Text:                          "2 hrs 42 mins",
+			TextIncludingOtherLanguage:    "2 hrs 42 mins",
+		},
+		Start: time.Date(2022, 10, 24, 15, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 10, 25, 14, 59, 59, 0, time.UTC),
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+}
@@ -0,0 +1,62 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type UserAgentsService service
+
+type UserAgents struct {
+	Data       []UserAgentsData `json:"data"`
+	Total      int              `json:"total"`
+	TotalPages int              `json:"total_pages"`
+}
+
+type UserAgentsData struct {
+	Id                 string    `json:"id"`
+	Value              string    `json:"value"`
+	Editor             string    `json:"editor"`
+	Version            string    `json:"version"`
+	Os                 string    `json:"os"`
+	IsBrowserExtension bool      `json:"is_browser_extension"`
+	IsDesktopApp       bool      `json:"is_desktop_app"`
+	LastSeenAt         null.Time `json:"last_seen_at"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// ById returns the user agents keyed by the user_agent_id used in heartbeats.
+func (u *UserAgents) ById() map[string]UserAgentsData {
+	userAgents := make(map[string]UserAgentsData, len(u.Data))
+	for _, userAgent := range u.Data {
+		userAgents[userAgent.Id] = userAgent
+	}
+
+	return userAgents
+}
+
+func (service *UserAgentsService) GetAll(ctx context.Context, userId string) (*UserAgents, error) {
+	v, _, err := service.GetAllWithResponse(ctx, userId)
+	return v, err
+}
+
+// GetAllWithResponse is like GetAll, and also returns the API response.
+func (service *UserAgentsService) GetAllWithResponse(ctx context.Context, userId string) (*UserAgents, *Response, error) {
+	path := fmt.Sprintf("users/%v/user_agents", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(UserAgents)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
@@ -0,0 +1,64 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestUserAgents_GetAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": [
+			{
+				"created_at": "2022-10-13T01:22:05Z",
+				"editor": "vscode",
+				"id": "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d",
+				"is_browser_extension": false,
+				"is_desktop_app": false,
+				"last_seen_at": "2022-11-01T11:05:17Z",
+				"os": "darwin",
+				"value": "wakatime/v1.57.0 (darwin-21.6.0-arm64) go1.19.1 vscode/1.72.2 vscode-wakatime/24.0.0",
+				"version": "24.0.0"
+			}
+		],
+		"total": 1,
+		"total_pages": 1
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current/user_agents"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.UserAgentsService.GetAll(context.Background(), "current")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userAgent := UserAgentsData{
+		Id:         "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d",
+		Value:      "wakatime/v1.57.0 (darwin-21.6.0-arm64) go1.19.1 vscode/1.72.2 vscode-wakatime/24.0.0",
+		Editor:     "vscode",
+		Version:    "24.0.0",
+		Os:         "darwin",
+		LastSeenAt: null.TimeFrom(time.Date(2022, 11, 1, 11, 5, 17, 0, time.UTC)),
+		CreatedAt:  time.Date(2022, 10, 13, 1, 22, 5, 0, time.UTC),
+	}
+	expected := UserAgents{
+		Data:       []UserAgentsData{userAgent},
+		Total:      1,
+		TotalPages: 1,
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+	assert.Equal(t, map[string]UserAgentsData{userAgent.Id: userAgent}, res.ById())
+}
@@ -0,0 +1,75 @@
+package wakago
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type UsersService service
+
+type User struct {
+	Data UserData `json:"data"`
+}
+
+type UserData struct {
+	Id                   string       `json:"id"`
+	Username             null.String  `json:"username"`
+	DisplayName          string       `json:"display_name"`
+	FullName             null.String  `json:"full_name"`
+	Email                null.String  `json:"email"`
+	PublicEmail          null.String  `json:"public_email"`
+	IsEmailPublic        bool         `json:"is_email_public"`
+	IsEmailConfirmed     bool         `json:"is_email_confirmed"`
+	Photo                string       `json:"photo"`
+	PhotoPublic          bool         `json:"photo_public"`
+	Timezone             string       `json:"timezone"`
+	Plan                 string       `json:"plan"`
+	HasPremiumFeatures   bool         `json:"has_premium_features"`
+	Website              null.String  `json:"website"`
+	HumanReadableWebsite null.String  `json:"human_readable_website"`
+	City                 *LeadersCity `json:"city"`
+	IsHireable           bool         `json:"is_hireable"`
+	LoggedTimePublic     bool         `json:"logged_time_public"`
+	LanguagesUsedPublic  bool         `json:"languages_used_public"`
+	GithubUsername       null.String  `json:"github_username"`
+	TwitterUsername      null.String  `json:"twitter_username"`
+	LinkedinUsername     null.String  `json:"linkedin_username"`
+	LastHeartbeatAt      null.Time    `json:"last_heartbeat_at"`
+	LastPlugin           null.String  `json:"last_plugin"`
+	LastPluginName       null.String  `json:"last_plugin_name"`
+	LastProject          null.String  `json:"last_project"`
+	LastBranch           null.String  `json:"last_branch"`
+	CreatedAt            time.Time    `json:"created_at"`
+	ModifiedAt           null.Time    `json:"modified_at"`
+}
+
+// Location loads the user's timezone, which WakaTime uses for day boundaries in durations, summaries and goals.
+func (u *UserData) Location() (*time.Location, error) {
+	return time.LoadLocation(u.Timezone)
+}
+
+func (service *UsersService) Get(ctx context.Context, userId string) (*User, error) {
+	v, _, err := service.GetWithResponse(ctx, userId)
+	return v, err
+}
+
+// GetWithResponse is like Get, and also returns the API response.
+func (service *UsersService) GetWithResponse(ctx context.Context, userId string) (*User, *Response, error) {
+	path := fmt.Sprintf("users/%v", userId)
+
+	request, err := service.client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(User)
+	response, err := service.client.DoWithResponse(ctx, request, v)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return v, response, nil
+}
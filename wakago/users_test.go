@@ -0,0 +1,92 @@
+package wakago
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestUsers_Get(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dummyResponse := `
+	{
+		"data": {
+			"city": null,
+			"created_at": "2022-10-13T01:22:05Z",
+			"display_name": "yamash723",
+			"email": "test@example.com",
+			"full_name": "Shuhei Yamashita",
+			"github_username": "yamash723",
+			"has_premium_features": false,
+			"human_readable_website": null,
+			"id": "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			"is_email_confirmed": true,
+			"is_email_public": false,
+			"is_hireable": false,
+			"languages_used_public": true,
+			"last_branch": "main",
+			"last_heartbeat_at": "2022-11-01T11:05:17Z",
+			"last_plugin": "vscode/1.72.2 vscode-wakatime/24.0.0",
+			"last_plugin_name": "VS Code",
+			"last_project": "wakago",
+			"linkedin_username": null,
+			"logged_time_public": true,
+			"modified_at": null,
+			"photo": "https://wakatime.com/photo/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			"photo_public": true,
+			"plan": "free",
+			"public_email": null,
+			"timezone": "Asia/Tokyo",
+			"twitter_username": null,
+			"username": "yamash723",
+			"website": null
+		}
+	}`
+
+	url := "https://wakatime.com/api/v1/users/current"
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, dummyResponse))
+
+	client := NewClient(nil)
+	res, err := client.UsersService.Get(context.Background(), "current")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := User{
+		Data: UserData{
+			Id:                  "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			Username:            null.StringFrom("yamash723"),
+			DisplayName:         "yamash723",
+			FullName:            null.StringFrom("Shuhei Yamashita"),
+			Email:               null.StringFrom("test@example.com"),
+			IsEmailConfirmed:    true,
+			Photo:               "https://wakatime.com/photo/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+			PhotoPublic:         true,
+			Timezone:            "Asia/Tokyo",
+			Plan:                "free",
+			LoggedTimePublic:    true,
+			LanguagesUsedPublic: true,
+			GithubUsername:      null.StringFrom("yamash723"),
+			LastHeartbeatAt:     null.TimeFrom(time.Date(2022, 11, 1, 11, 5, 17, 0, time.UTC)),
+			LastPlugin:          null.StringFrom("vscode/1.72.2 vscode-wakatime/24.0.0"),
+			LastPluginName:      null.StringFrom("VS Code"),
+			LastProject:         null.StringFrom("wakago"),
+			LastBranch:          null.StringFrom("main"),
+			CreatedAt:           time.Date(2022, 10, 13, 1, 22, 5, 0, time.UTC),
+		},
+	}
+
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.EqualValues(t, &expected, res)
+
+	location, err := res.Data.Location()
+	assert.Nil(t, err)
+	assert.Equal(t, "Asia/Tokyo", location.String())
+}
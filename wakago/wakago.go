@@ -5,10 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const (
@@ -22,37 +22,113 @@ type Client struct {
 	client  *http.Client
 	baseURL *url.URL
 
+	authenticator Authenticator
+	logger        Logger
+	rateLimiter   RateLimiter
+	retryPolicy   RetryPolicy
+
 	UserAgent     string
 	DefaultHeader *http.Header
 
-	AllTimeSinceTodayService *AllTimeSinceTodayService
-	CommitsService           *CommitsService
-	DurationsService         *DurationsService
-	EditorsService           *EditorsService
-	GoalsService             *GoalsService
-	MetaService              *MetaService
+	// CompatibilityMode tolerates responses from WakaTime compatible servers such as Wakapi,
+	// which omit some fields or send timestamps in other layouts.
+	CompatibilityMode bool
+
+	AllTimeSinceTodayService   *AllTimeSinceTodayService
+	CommitsService             *CommitsService
+	DataDumpsService           *DataDumpsService
+	DurationsService           *DurationsService
+	EditorsService             *EditorsService
+	ExternalDurationsService   *ExternalDurationsService
+	FileExpertsService         *FileExpertsService
+	GoalsService               *GoalsService
+	HeartbeatsService          *HeartbeatsService
+	InsightsService            *InsightsService
+	LeadersService             *LeadersService
+	MachineNamesService        *MachineNamesService
+	MetaService                *MetaService
+	OrgsService                *OrgsService
+	PrivateLeaderboardsService *PrivateLeaderboardsService
+	ProgramLanguagesService    *ProgramLanguagesService
+	ProjectsService            *ProjectsService
+	StatsService               *StatsService
+	StatusBarService           *StatusBarService
+	SummariesService           *SummariesService
+	UserAgentsService          *UserAgentsService
+	UsersService               *UsersService
 }
 
 type service struct {
 	client *Client
 }
 
+// NewClient returns a client for the WakaTime API sending requests with httpClient, or a new http.Client when it is nil.
+// Use New to configure the client with options.
 func NewClient(httpClient *http.Client) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{}
-	}
+	c, _ := New(WithHTTPClient(httpClient))
+	return c
+}
 
+// New returns a client for the WakaTime API configured with opts.
+func New(opts ...Option) (*Client, error) {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{client: &http.Client{}, baseURL: baseURL, UserAgent: defaultUserAgent}
 	c.AllTimeSinceTodayService = &AllTimeSinceTodayService{client: c}
 	c.CommitsService = &CommitsService{client: c}
+	c.DataDumpsService = &DataDumpsService{client: c}
 	c.DurationsService = &DurationsService{client: c}
 	c.EditorsService = &EditorsService{client: c}
+	c.ExternalDurationsService = &ExternalDurationsService{client: c}
+	c.FileExpertsService = &FileExpertsService{client: c}
 	c.GoalsService = &GoalsService{client: c}
+	c.HeartbeatsService = &HeartbeatsService{client: c}
+	c.InsightsService = &InsightsService{client: c}
+	c.LeadersService = &LeadersService{client: c}
+	c.MachineNamesService = &MachineNamesService{client: c}
 	c.MetaService = &MetaService{client: c}
+	c.OrgsService = &OrgsService{client: c}
+	c.PrivateLeaderboardsService = &PrivateLeaderboardsService{client: c}
+	c.ProgramLanguagesService = &ProgramLanguagesService{client: c}
+	c.ProjectsService = &ProjectsService{client: c}
+	c.StatsService = &StatsService{client: c}
+	c.StatusBarService = &StatusBarService{client: c}
+	c.SummariesService = &SummariesService{client: c}
+	c.UserAgentsService = &UserAgentsService{client: c}
+	c.UsersService = &UsersService{client: c}
 
-	return c
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// NewClientWithBaseURL returns a client for a WakaTime compatible server serving its API at baseURL.
+func NewClientWithBaseURL(httpClient *http.Client, baseURL string) (*Client, error) {
+	return New(WithHTTPClient(httpClient), WithBaseURL(baseURL))
+}
+
+// NewWakapiClient returns a client for the Wakapi server at serverURL, with CompatibilityMode enabled.
+func NewWakapiClient(httpClient *http.Client, serverURL string) (*Client, error) {
+	return New(WithHTTPClient(httpClient), WithWakapi(serverURL))
+}
+
+func (c *Client) BaseURL() *url.URL {
+	u := *c.baseURL
+	return &u
+}
+
+func (c *Client) SetBaseURL(baseURL string) error {
+	u, err := parseBaseURL(baseURL)
+	if err != nil {
+		return err
+	}
+
+	c.baseURL = u
+	return nil
 }
 
 func (c *Client) NewRequest(method, path string, body interface{}) (*http.Request, error) {
@@ -76,7 +152,7 @@ func (c *Client) NewRequest(method, path string, body interface{}) (*http.Reques
 	}
 
 	if c.DefaultHeader != nil {
-		request.Header = *c.DefaultHeader
+		request.Header = c.DefaultHeader.Clone()
 	}
 
 	if body != nil {
@@ -90,44 +166,154 @@ func (c *Client) NewRequest(method, path string, body interface{}) (*http.Reques
 	return request, nil
 }
 
+// Do sends the request and decodes the JSON response body into v.
+// When v is an io.Writer the body is copied to it as is, without being buffered.
+// Error status codes are returned as an *ErrorResponse, together with the response.
+//
+// Each attempt waits for the client's RateLimiter first, and failed attempts are sent again as the RetryPolicy decides.
 func (c *Client) Do(ctx context.Context, request *http.Request, v interface{}) (*http.Response, error) {
+	response, err := c.do(ctx, request, v, false)
+	if response == nil {
+		return nil, err
+	}
+
+	return response.Response, err
+}
+
+// DoWithResponse is like Do, and returns the response with its pagination, rate limit and freshness metadata parsed.
+func (c *Client) DoWithResponse(ctx context.Context, request *http.Request, v interface{}) (*Response, error) {
+	return c.do(ctx, request, v, true)
+}
+
+func (c *Client) do(ctx context.Context, request *http.Request, v interface{}, parseBody bool) (*Response, error) {
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
 
-	request = request.WithContext(ctx)
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		// Rate limiter and authenticator errors are not transient, so they are returned without retrying.
+		prepared, err := c.prepare(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := c.send(prepared)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
 
-	response, err := c.client.Do(request)
-	if err != nil {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+		if c.retryPolicy != nil {
+			retry := RetryAttempt{Attempt: attempt, Elapsed: time.Since(start), Request: request, Response: response, Err: err}
+			if delay, ok := c.retryPolicy.Retry(retry); ok {
+				next, rewindErr := rewindRequest(request)
+				if rewindErr == nil {
+					if response != nil {
+						io.Copy(io.Discard, response.Body)
+						response.Body.Close()
+					}
+
+					if err := sleep(ctx, delay); err != nil {
+						return nil, err
+					}
+
+					request = next
+					continue
+				}
+			}
 		}
 
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
+
+		return c.handleResponse(request, response, v, parseBody)
 	}
+}
 
-	defer response.Body.Close()
+// prepare waits for the rate limiter and returns a copy of request bound to ctx, with its credentials added.
+func (c *Client) prepare(ctx context.Context, request *http.Request) (*http.Request, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
 
-	if err != nil {
-		return nil, err
+	request = request.Clone(ctx)
+	if c.authenticator != nil && c.isAPIURL(request.URL) {
+		if err := c.authenticator.Authenticate(request); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := CheckHttpStatusCode(response.StatusCode); err != nil {
-		return response, err
+	return request, nil
+}
+
+func (c *Client) send(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := c.client.Do(request)
+	if urlErr, ok := err.(*url.Error); ok {
+		urlErr.URL = redactURL(request.URL)
 	}
 
-	if v != nil {
-		err = json.NewDecoder(response.Body).Decode(v)
+	if c.logger != nil {
 		if err != nil {
-			return nil, err
+			c.logger.Printf("wakago: %v %v failed in %v: %v", request.Method, redactURL(request.URL), time.Since(start), err)
+		} else {
+			c.logger.Printf("wakago: %v %v %v in %v", request.Method, redactURL(request.URL), response.StatusCode, time.Since(start))
 		}
 	}
 
 	return response, err
 }
 
+// handleResponse decodes the body of response into v.
+// parseBody also reads the pagination fields of the body into the returned Response, buffering it to do so.
+func (c *Client) handleResponse(request *http.Request, response *http.Response, v interface{}, parseBody bool) (*Response, error) {
+	defer response.Body.Close()
+	r := NewResponse(response)
+
+	if err := CheckHttpStatusCode(response.StatusCode); err != nil {
+		return r, newErrorResponse(request, response)
+	}
+
+	if w, ok := v.(io.Writer); ok {
+		_, err := io.Copy(w, response.Body)
+		return r, err
+	}
+
+	if v == nil {
+		return r, nil
+	}
+
+	// The body is only buffered when it has to be read twice.
+	if !c.CompatibilityMode && !parseBody {
+		return r, json.NewDecoder(response.Body).Decode(v)
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return r, err
+	}
+
+	if c.CompatibilityMode {
+		data, err = normalizeCompatJSON(data)
+		if err != nil {
+			return r, err
+		}
+	}
+
+	if parseBody {
+		r.parseBody(data)
+	}
+
+	return r, json.Unmarshal(data, v)
+}
+
 // HTTP response codes
 //
 // 200 - Ok: The request has succeeded.
@@ -137,14 +323,16 @@ func (c *Client) Do(ctx context.Context, request *http.Request, v interface{}) (
 // 401 - Unauthorized: The request requires authentication, or your authentication was invalid.
 // 403 - Forbidden: You are authenticated, but do not have permission to access the resource.
 // 404 - Not Found: The resource does not exist.
-// 429 - Too Many Requests: You are being rate limited, try making fewer than 10 requests per second on average over any 5 minute period.
+// 429 - Too Many Requests: You are being rate limited, try making fewer than 10 requests per second on average over any 5 minute period. See NewDefaultRateLimiter.
 // 500 - Server Error: Service unavailable, try again later.
 //
+// The error matches the sentinel errors with errors.Is.
+//
 // refer : https://wakatime.com/developers#introduction
 func CheckHttpStatusCode(statusCode int) error {
 	if statusCode >= 200 && statusCode <= 399 {
 		return nil
 	}
 
-	return errors.New(fmt.Sprintf("Return error HTTP response code : %v", statusCode))
+	return statusError(statusCode)
 }
@@ -1,12 +1,14 @@
 package wakago
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -153,3 +155,67 @@ func TestDo_httpError(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, 400, response.StatusCode)
 }
+
+func TestDo_writer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://wakatime.com/api/v1/foo",
+		httpmock.NewStringResponder(200, `{"success":true}`))
+
+	c := NewClient(nil)
+	request, _ := c.NewRequest("GET", "/foo", nil)
+
+	buf := &bytes.Buffer{}
+	_, err := c.Do(context.Background(), request, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"success":true}`, buf.String())
+}
+
+func TestNewClientWithBaseURL(t *testing.T) {
+	c, err := NewClientWithBaseURL(nil, "https://wakatime.example.com/api/v1")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://wakatime.example.com/api/v1/", c.BaseURL().String())
+	assert.False(t, c.CompatibilityMode)
+
+	request, _ := c.NewRequest("GET", "users/current", nil)
+	assert.Equal(t, "https://wakatime.example.com/api/v1/users/current", request.URL.String())
+
+	_, err = NewClientWithBaseURL(nil, "/api/v1")
+	assert.NotNil(t, err)
+}
+
+func TestNewWakapiClient(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Wakapi omits the timezone of the range and sends cached_at without a UTC offset.
+	dummyResponse := `
+	{
+		"cached_at": "2022-10-27 10:30:16",
+		"data": {
+			"grand_total": {"total_seconds": 4745.996201, "text": "1 hr 19 mins"},
+			"range": {"date": "2022-10-27", "start": "2022-10-26T15:00:00Z", "end": "2022-10-27T14:59:59Z", "text": ""}
+		}
+	}`
+
+	httpmock.RegisterResponder("GET", "https://wakapi.dev/api/compat/wakatime/v1/users/current/status_bar/today",
+		httpmock.NewStringResponder(200, dummyResponse))
+
+	c, err := NewWakapiClient(nil, "https://wakapi.dev")
+	assert.Nil(t, err)
+	assert.True(t, c.CompatibilityMode)
+
+	res, err := c.StatusBarService.Today(context.Background(), "current")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, time.Date(2022, 10, 27, 10, 30, 16, 0, time.UTC), res.CachedAt)
+	assert.Equal(t, "", res.Data.Range.Timezone)
+	assert.Equal(t, 4745.996201, res.Data.GrandTotal.TotalSeconds)
+
+	c.CompatibilityMode = false
+	_, err = c.StatusBarService.Today(context.Background(), "current")
+	assert.NotNil(t, err)
+}